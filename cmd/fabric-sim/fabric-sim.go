@@ -7,6 +7,7 @@ package main
 
 import (
 	"github.com/onosproject/fabric-sim/pkg/manager"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
 	"github.com/onosproject/onos-lib-go/pkg/cli"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/spf13/cobra"
@@ -14,6 +15,13 @@ import (
 
 var log = logging.GetLogger()
 
+const (
+	topologyFlag       = "topology"
+	validateSchemaFlag = "validate-schema"
+	configDirFlag      = "config-dir"
+	rebootDowntimeFlag = "reboot-downtime"
+)
+
 // The main entry point
 func main() {
 	cmd := &cobra.Command{
@@ -21,6 +29,10 @@ func main() {
 		RunE: runRootCommand,
 	}
 	cli.AddServiceEndpointFlags(cmd, "fabric-sim gRPC")
+	cmd.Flags().String(topologyFlag, "", "topology YAML file to load on startup")
+	cmd.Flags().Bool(validateSchemaFlag, false, "validate gNMI get and set requests against the OpenConfig models")
+	cmd.Flags().String(configDirFlag, "", "directory in which to persist the gNMI configuration of devices")
+	cmd.Flags().Duration(rebootDowntimeFlag, simulator.DefaultRebootDowntime, "time for which devices stay unreachable during a cold reboot")
 	cli.Run(cmd)
 }
 
@@ -30,6 +42,12 @@ func runRootCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	topologyPath, _ := cmd.Flags().GetString(topologyFlag)
+	validateSchema, _ := cmd.Flags().GetBool(validateSchemaFlag)
+	configDir, _ := cmd.Flags().GetString(configDirFlag)
+	rebootDowntime, _ := cmd.Flags().GetDuration(rebootDowntimeFlag)
+
 	log.Info("Starting fabric-sim")
-	return cli.RunDaemon(manager.NewManager(manager.Config{ServiceFlags: flags}))
+	return cli.RunDaemon(manager.NewManager(manager.Config{ServiceFlags: flags, TopologyPath: topologyPath,
+		ValidateSchema: validateSchema, ConfigDir: configDir, RebootDowntime: rebootDowntime}))
 }
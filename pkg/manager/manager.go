@@ -11,13 +11,18 @@ import (
 	"github.com/onosproject/onos-lib-go/pkg/cli"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-lib-go/pkg/northbound"
+	"time"
 )
 
 var log = logging.GetLogger("manager")
 
 // Config is a manager configuration
 type Config struct {
-	ServiceFlags *cli.ServiceEndpointFlags
+	ServiceFlags   *cli.ServiceEndpointFlags
+	TopologyPath   string
+	ValidateSchema bool
+	ConfigDir      string
+	RebootDowntime time.Duration
 }
 
 // Manager is single point of entry for the fabric-sim
@@ -40,6 +45,20 @@ func (m *Manager) Start() error {
 	// Initialize the simulation core
 	m.simulation = simulator.NewSimulation()
 	m.simulation.Collector.Start()
+	m.simulation.SetSchemaValidation(m.Config.ValidateSchema)
+	if err := m.simulation.SetConfigDir(m.Config.ConfigDir); err != nil {
+		return err
+	}
+	if err := m.simulation.SetRebootDowntime(m.Config.RebootDowntime); err != nil {
+		return err
+	}
+
+	// Load the initial topology, if one was specified
+	if len(m.Config.TopologyPath) > 0 {
+		if err := loadTopology(m.simulation, m.Config.TopologyPath); err != nil {
+			return err
+		}
+	}
 
 	// Starts NB server
 	s := northbound.NewServer(cli.ServerConfigFromFlags(m.Config.ServiceFlags, northbound.SecurityConfig{}))
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	simapi "github.com/onosproject/fabric-sim/pkg/northbound/fabricsim"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+)
+
+const loaderBufferSize = 1 << 20
+
+// Loads the specified topology YAML file into the given simulation via the fabric simulator API, served for the
+// duration of the load over an in-memory connection, so that the topology is applied just as if it was loaded
+// using the fabric-sim-topo tool
+func loadTopology(simulation *simulator.Simulation, path string) error {
+	listener := bufconn.Listen(loaderBufferSize)
+	server := grpc.NewServer()
+	simapi.NewService(simulation).Register(server)
+	go func() {
+		if err := server.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+			log.Errorf("Unable to serve topology loader: %+v", err)
+		}
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+	return topo.LoadTopology(conn, path)
+}
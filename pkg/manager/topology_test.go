@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testTopology = `
+devices:
+  - id: leaf1
+    type: switch
+    agent_port: 20101
+    stopped: true
+    ports:
+      - number: 1
+        speed: 100Gbps
+      - number: 2
+        speed: 100Gbps
+        breakout: 4x25G
+      - number: 3
+        speed: 10Gbps
+      - number: 4
+        speed: 10Gbps
+      - number: 5
+        speed: 10Gbps
+    lags:
+      - name: po1
+        members: [3, 4]
+        min_links: 1
+  - id: leaf2
+    type: switch
+    agent_port: 20102
+    stopped: true
+    ports:
+      - number: 1
+        speed: 100Gbps
+      - number: 2
+        speed: 10Gbps
+
+links:
+  - src: leaf1/1
+    tgt: leaf2/1
+    impairment:
+      latency: 5ms
+    faults:
+      in_errors: 2
+    flap:
+      count: 1
+      down: 1s
+      delay: 1h
+
+hosts:
+  - id: h1
+    nics:
+      - mac: 00:00:00:00:00:01
+        port: leaf1/5
+    behavior:
+      profile: silent
+    multicast_groups: [239.1.1.1]
+  - id: h2
+    nics:
+      - mac: 00:00:00:00:00:02
+        port: leaf2/2
+
+traffic:
+  - src: h1
+    dst: h2
+    rate: 10Mbps
+`
+
+func TestLoadTopology(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "topology.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(testTopology), 0644))
+
+	simulation := simulator.NewSimulation()
+	assert.NoError(t, loadTopology(simulation, path))
+
+	// Devices come with their port breakouts and LAGs
+	leaf1, err := simulation.GetDeviceSimulator("leaf1")
+	assert.NoError(t, err)
+	assert.Contains(t, leaf1.Ports, simapi.PortID("leaf1/2:4"))
+	lags := leaf1.GetLAGs()
+	assert.Len(t, lags, 1)
+
+	// Links come in both directions with their impairment and faults
+	for _, id := range []simapi.LinkID{"leaf1/1-leaf2/1", "leaf2/1-leaf1/1"} {
+		link, err := simulation.GetLinkSimulator(id)
+		assert.NoError(t, err)
+		assert.Equal(t, 5*time.Millisecond, link.Impairment().Latency)
+	}
+	faults, err := leaf1.GetPortFaults("leaf1/1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, faults.InErrors)
+
+	// Hosts come with their behavior and multicast groups
+	h1, err := simulation.GetHostSimulator("h1")
+	assert.NoError(t, err)
+	assert.Equal(t, simulator.SilentProfile, h1.Behavior.Profile)
+	assert.Equal(t, []string{"239.1.1.1"}, h1.Groups())
+	h2, err := simulation.GetHostSimulator("h2")
+	assert.NoError(t, err)
+	assert.Equal(t, simulator.DefaultProfile, h2.Behavior.Profile)
+
+	// Traffic matrix is set
+	matrix := simulation.GetTrafficMatrix()
+	assert.Len(t, matrix, 1)
+	assert.Equal(t, uint64(10e6), matrix[0].Rate)
+	assert.NoError(t, simulation.SetTrafficMatrix(nil))
+
+	// Invalid behaviors fail the load
+	assert.NoError(t, os.WriteFile(path, []byte(`
+devices:
+  - id: leaf3
+    stopped: true
+    ports:
+      - number: 1
+hosts:
+  - id: h3
+    nics:
+      - mac: 00:00:00:00:00:03
+        port: leaf3/1
+    behavior:
+      profile: noisy
+`), 0644))
+	assert.Error(t, loadTopology(simulation, path))
+}
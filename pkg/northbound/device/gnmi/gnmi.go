@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gnmi implements the simulated gNMI service
+package gnmi
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-api/go/onos/misc"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/onosproject/onos-net-lib/pkg/gnmiserver"
+	gnmiapi "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/peer"
+	"io"
+	"time"
+)
+
+var log = logging.GetLogger("northbound", "device", "gnmi")
+
+// Server implements the gNMI API; Capabilities, Get, Set and Subscribe requests are processed by the device simulator so that they honor
+// the request options and drive the simulated behavior, while the rest is handled by the generic configtree-backed
+// server
+type Server struct {
+	*gnmiserver.GNMIServer
+	deviceID   simapi.DeviceID
+	simulation *simulator.Simulation
+	deviceSim  *simulator.DeviceSimulator
+}
+
+// NewServer creates a new gNMI API server
+func NewServer(deviceID simapi.DeviceID, simulation *simulator.Simulation) *Server {
+	sim, err := simulation.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return nil
+	}
+	return &Server{
+		GNMIServer: gnmiserver.NewGNMIServer(&sim.GNMIConfigurable, fmt.Sprintf("Device %s", deviceID)),
+		deviceID:   deviceID,
+		simulation: simulation,
+		deviceSim:  sim,
+	}
+}
+
+// Capabilities responds with the models and encodings supported by the device, along with the gNMI version; the
+// simulator validates requests only against an approximation of these models, which covers the leaves it simulates
+func (s *Server) Capabilities(ctx context.Context, request *gnmiapi.CapabilityRequest) (*gnmiapi.CapabilityResponse, error) {
+	response, err := s.GNMIServer.Capabilities(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	response.SupportedModels = config.Models
+	response.SupportedEncodings = config.Encodings
+	return response, nil
+}
+
+// Get retrieves a snapshot of the requested device configuration and state
+func (s *Server) Get(ctx context.Context, request *gnmiapi.GetRequest) (*gnmiapi.GetResponse, error) {
+	log.Infof("Device %s: gNMI get request received", s.deviceID)
+	notifications, err := s.deviceSim.ProcessGetRequest(request)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &gnmiapi.GetResponse{Notification: notifications}, nil
+}
+
+// Set applies the requested deletes, replacements and updates to the device configuration
+func (s *Server) Set(ctx context.Context, request *gnmiapi.SetRequest) (*gnmiapi.SetResponse, error) {
+	log.Infof("Device %s: gNMI set request received", s.deviceID)
+	results, err := s.deviceSim.ProcessConfigSet(request.Prefix, request.Update, request.Replace, request.Delete)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &gnmiapi.SetResponse{
+		Prefix:    request.Prefix,
+		Response:  results,
+		Timestamp: time.Now().UnixNano(),
+	}, nil
+}
+
+// Subscribe processes the subscription list and any subsequent polls received on the stream, and streams the
+// subscribed values per the requested mode until the stream is closed, or until the initial values are sent for the
+// ONCE mode
+func (s *Server) Subscribe(server gnmiapi.GNMI_SubscribeServer) error {
+	log.Infof("Device %s: gNMI subscription stream opened", s.deviceID)
+	var connection *misc.Connection
+	if p, ok := peer.FromContext(server.Context()); ok {
+		connection = &misc.Connection{
+			FromAddress: p.Addr.String(),
+			Protocol:    "gnmi",
+			Time:        time.Now().Unix(),
+		}
+	}
+
+	subscription := s.deviceSim.NewGNMISubscription(server.Send, connection)
+	defer subscription.Close()
+	defer log.Infof("Device %s: gNMI subscription stream closed", s.deviceID)
+
+	// Receive the requests separately, so that a subscription dropped by the simulator ends the stream right away
+	type received struct {
+		request *gnmiapi.SubscribeRequest
+		err     error
+	}
+	requests := make(chan received)
+	go func() {
+		for {
+			request, err := server.Recv()
+			select {
+			case requests <- received{request: request, err: err}:
+			case <-server.Context().Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case r := <-requests:
+			if r.err == io.EOF {
+				return nil
+			}
+			if r.err != nil {
+				return r.err
+			}
+			done, err := subscription.Process(r.request)
+			if err != nil {
+				log.Warnf("Device %s: Unable to process subscribe request: %+v", s.deviceID, err)
+				return errors.Status(err).Err()
+			}
+			if done {
+				return nil
+			}
+		case <-subscription.Done():
+			return errors.Status(subscription.Err()).Err()
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"context"
+	"crypto/md5"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	fileapi "github.com/openconfig/gnoi/file"
+	"github.com/openconfig/gnoi/types"
+	"io"
+)
+
+const (
+	// Size of the chunks in which file contents are streamed
+	fileChunkSize = 64 * 1024
+	// Umask of the virtual filesystem
+	fileUmask = 0022
+)
+
+// FileServer implements the gNOI File API
+type FileServer struct {
+	deviceID   simapi.DeviceID
+	simulation *simulator.Simulation
+	deviceSim  *simulator.DeviceSimulator
+	fileapi.UnimplementedFileServer
+}
+
+// NewFileServer creates a new gNOI File API server
+func NewFileServer(deviceID simapi.DeviceID, simulation *simulator.Simulation) *FileServer {
+	sim, err := simulation.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return nil
+	}
+	return &FileServer{
+		deviceID:   deviceID,
+		simulation: simulation,
+		deviceSim:  sim,
+	}
+}
+
+// Get streams the contents of the requested file, followed by their MD5 hash
+func (s FileServer) Get(request *fileapi.GetRequest, server fileapi.File_GetServer) error {
+	log.Infof("Device %s: Received file get request: %s", s.deviceID, request.RemoteFile)
+	file, err := s.deviceSim.GetFile(request.RemoteFile)
+	if err != nil {
+		return errors.Status(err).Err()
+	}
+	for i := 0; i < len(file.Contents); i += fileChunkSize {
+		end := i + fileChunkSize
+		if end > len(file.Contents) {
+			end = len(file.Contents)
+		}
+		if err = server.Send(&fileapi.GetResponse{Response: &fileapi.GetResponse_Contents{Contents: file.Contents[i:end]}}); err != nil {
+			return err
+		}
+	}
+	hash := md5.Sum(file.Contents)
+	return server.Send(&fileapi.GetResponse{
+		Response: &fileapi.GetResponse_Hash{Hash: &types.HashType{Method: types.HashType_MD5, Hash: hash[:]}},
+	})
+}
+
+// Put receives the contents of a file, verifies them against their hash and writes them to the requested file
+func (s FileServer) Put(server fileapi.File_PutServer) error {
+	var details *fileapi.PutRequest_Details
+	contents := make([]byte, 0)
+	for {
+		request, err := server.Recv()
+		if err == io.EOF {
+			return errors.Status(errors.NewInvalid("file hash not received")).Err()
+		} else if err != nil {
+			return err
+		}
+
+		switch r := request.Request.(type) {
+		case *fileapi.PutRequest_Open:
+			if details != nil {
+				return errors.Status(errors.NewInvalid("file already opened")).Err()
+			}
+			details = r.Open
+			log.Infof("Device %s: Receiving file %s", s.deviceID, details.RemoteFile)
+		case *fileapi.PutRequest_Contents:
+			if details == nil {
+				return errors.Status(errors.NewInvalid("file contents received before file opened")).Err()
+			}
+			contents = append(contents, r.Contents...)
+		case *fileapi.PutRequest_Hash:
+			if details == nil {
+				return errors.Status(errors.NewInvalid("file hash received before file opened")).Err()
+			}
+			if err = s.deviceSim.PutFile(details.RemoteFile, details.Permissions, contents, r.Hash); err != nil {
+				return errors.Status(err).Err()
+			}
+			return server.SendAndClose(&fileapi.PutResponse{})
+		}
+	}
+}
+
+// Stat returns the metadata of the requested file or of all files within the requested directory
+func (s FileServer) Stat(ctx context.Context, request *fileapi.StatRequest) (*fileapi.StatResponse, error) {
+	log.Debugf("Device %s: Received file stat request: %s", s.deviceID, request.Path)
+	files, err := s.deviceSim.StatFiles(request.Path)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	response := &fileapi.StatResponse{}
+	for _, file := range files {
+		response.Stats = append(response.Stats, &fileapi.StatInfo{
+			Path:         file.Path,
+			LastModified: uint64(file.LastModified.UnixNano()),
+			Permissions:  file.Permissions,
+			Size:         uint64(len(file.Contents)),
+			Umask:        fileUmask,
+		})
+	}
+	return response, nil
+}
+
+// Remove removes the requested file
+func (s FileServer) Remove(ctx context.Context, request *fileapi.RemoveRequest) (*fileapi.RemoveResponse, error) {
+	log.Infof("Device %s: Received file remove request: %s", s.deviceID, request.RemoteFile)
+	if err := s.deviceSim.RemoveFile(request.RemoteFile); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fileapi.RemoveResponse{}, nil
+}
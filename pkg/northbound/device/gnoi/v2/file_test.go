@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	fileapi "github.com/openconfig/gnoi/file"
+	"github.com/openconfig/gnoi/types"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestFilePutAndGet(t *testing.T) {
+	conn, _, _ := newTestConn(t)
+	client := fileapi.NewFileClient(conn)
+	ctx := context.Background()
+
+	put := func(requests ...*fileapi.PutRequest) error {
+		stream, err := client.Put(ctx)
+		assert.NoError(t, err)
+		for _, request := range requests {
+			assert.NoError(t, stream.Send(request))
+		}
+		_, err = stream.CloseAndRecv()
+		return errors.FromGRPC(err)
+	}
+	open := func(path string) *fileapi.PutRequest {
+		return &fileapi.PutRequest{Request: &fileapi.PutRequest_Open{
+			Open: &fileapi.PutRequest_Details{RemoteFile: path, Permissions: 0644}}}
+	}
+	chunk := func(b []byte) *fileapi.PutRequest {
+		return &fileapi.PutRequest{Request: &fileapi.PutRequest_Contents{Contents: b}}
+	}
+	hash := func(h []byte) *fileapi.PutRequest {
+		return &fileapi.PutRequest{Request: &fileapi.PutRequest_Hash{
+			Hash: &types.HashType{Method: types.HashType_MD5, Hash: h}}}
+	}
+
+	// File spanning several chunks is put and then streamed back in chunks, followed by its hash
+	contents := bytes.Repeat([]byte("fabric-sim"), fileChunkSize/4)
+	sum := md5.Sum(contents)
+	half := len(contents) / 2
+	assert.NoError(t, put(open("/etc/config"), chunk(contents[:half]), chunk(contents[half:]), hash(sum[:])))
+
+	stream, err := client.Get(ctx, &fileapi.GetRequest{RemoteFile: "/etc/config"})
+	assert.NoError(t, err)
+	received := make([]byte, 0)
+	chunks := 0
+	var digest *types.HashType
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if c := response.GetContents(); c != nil {
+			assert.LessOrEqual(t, len(c), fileChunkSize)
+			received = append(received, c...)
+			chunks++
+		} else {
+			digest = response.GetHash()
+		}
+	}
+	assert.Equal(t, contents, received)
+	assert.Equal(t, (len(contents)+fileChunkSize-1)/fileChunkSize, chunks)
+	assert.Equal(t, types.HashType_MD5, digest.Method)
+	assert.Equal(t, sum[:], digest.Hash)
+
+	// Out of order, incomplete or corrupt files are rejected
+	assert.True(t, errors.IsInvalid(put(chunk(contents), hash(sum[:]))))
+	assert.True(t, errors.IsInvalid(put(hash(sum[:]))))
+	assert.True(t, errors.IsInvalid(put(open("/etc/other"), open("/etc/other"))))
+	assert.True(t, errors.IsInvalid(put(open("/etc/other"), chunk(contents))))
+	assert.True(t, errors.IsInvalid(put(open("/etc/other"), chunk(contents), hash([]byte("bad")))))
+	assert.True(t, errors.IsInvalid(put(open("etc/other"), chunk(contents), hash(sum[:]))))
+
+	stream, err = client.Get(ctx, &fileapi.GetRequest{RemoteFile: "/etc/other"})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.True(t, errors.IsNotFound(errors.FromGRPC(err)))
+}
+
+func TestFileStatAndRemove(t *testing.T) {
+	conn, _, ds := newTestConn(t)
+	client := fileapi.NewFileClient(conn)
+	ctx := context.Background()
+
+	for _, path := range []string{"/etc/config", "/etc/motd"} {
+		contents := []byte(path)
+		sum := md5.Sum(contents)
+		assert.NoError(t, ds.PutFile(path, 0600, contents, &types.HashType{Method: types.HashType_MD5, Hash: sum[:]}))
+	}
+
+	response, err := client.Stat(ctx, &fileapi.StatRequest{Path: "/etc/config"})
+	assert.NoError(t, err)
+	assert.Len(t, response.Stats, 1)
+	assert.Equal(t, uint64(len("/etc/config")), response.Stats[0].Size)
+	assert.Equal(t, uint32(0600), response.Stats[0].Permissions)
+	assert.Equal(t, uint32(fileUmask), response.Stats[0].Umask)
+	assert.NotZero(t, response.Stats[0].LastModified)
+	response, err = client.Stat(ctx, &fileapi.StatRequest{Path: "/etc"})
+	assert.NoError(t, err)
+	assert.Len(t, response.Stats, 2)
+
+	_, err = client.Remove(ctx, &fileapi.RemoveRequest{RemoteFile: "/etc/config"})
+	assert.NoError(t, err)
+	_, err = client.Remove(ctx, &fileapi.RemoveRequest{RemoteFile: "/etc/config"})
+	assert.True(t, errors.IsNotFound(errors.FromGRPC(err)))
+	response, err = client.Stat(ctx, &fileapi.StatRequest{Path: "/etc"})
+	assert.NoError(t, err)
+	assert.Len(t, response.Stats, 1)
+}
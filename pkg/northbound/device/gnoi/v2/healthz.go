@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"context"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnoi/healthz"
+)
+
+// HealthzServer implements the gNOI Healthz API
+type HealthzServer struct {
+	deviceID   simapi.DeviceID
+	simulation *simulator.Simulation
+	deviceSim  *simulator.DeviceSimulator
+	healthz.UnimplementedHealthzServer
+}
+
+// NewHealthzServer creates a new gNOI Healthz API server
+func NewHealthzServer(deviceID simapi.DeviceID, simulation *simulator.Simulation) *HealthzServer {
+	sim, err := simulation.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return nil
+	}
+	return &HealthzServer{
+		deviceID:   deviceID,
+		simulation: simulation,
+		deviceSim:  sim,
+	}
+}
+
+// Get returns the health of the requested component and its subcomponents
+func (s HealthzServer) Get(ctx context.Context, request *healthz.GetRequest) (*healthz.GetResponse, error) {
+	log.Debugf("Device %s: Received healthz get request: %v", s.deviceID, request.Path)
+	name, err := simulator.ComponentName(request.Path)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	status, err := s.deviceSim.GetComponentHealth(name)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &healthz.GetResponse{Component: status}, nil
+}
+
+// List returns the health events of the requested component and its subcomponents
+func (s HealthzServer) List(ctx context.Context, request *healthz.ListRequest) (*healthz.ListResponse, error) {
+	log.Debugf("Device %s: Received healthz list request: %v", s.deviceID, request.Path)
+	name, err := simulator.ComponentName(request.Path)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	statuses, err := s.deviceSim.ListComponentHealth(name, request.IncludeAcknowledged)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &healthz.ListResponse{Status: statuses}, nil
+}
+
+// Acknowledge acknowledges the requested health event of the requested component
+func (s HealthzServer) Acknowledge(ctx context.Context, request *healthz.AcknowlegeRequest) (*healthz.AcknowledgeResponse, error) {
+	log.Infof("Device %s: Received healthz acknowledge request: %v %s", s.deviceID, request.Path, request.Id)
+	name, err := simulator.ComponentName(request.Path)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	status, err := s.deviceSim.AcknowledgeComponentHealth(name, request.Id)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &healthz.AcknowledgeResponse{Status: status}, nil
+}
+
+// Check re-evaluates and returns the health of the requested component
+func (s HealthzServer) Check(ctx context.Context, request *healthz.CheckRequest) (*healthz.CheckResponse, error) {
+	log.Debugf("Device %s: Received healthz check request: %v", s.deviceID, request.Path)
+	name, err := simulator.ComponentName(request.Path)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	status, err := s.deviceSim.GetComponentHealth(name)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &healthz.CheckResponse{Status: status}, nil
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"context"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnoi/healthz"
+	"github.com/openconfig/gnoi/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// Returns the gNOI path of the component with the given name
+func componentPath(name string) *types.Path {
+	return &types.Path{Elem: []*types.PathElem{{Name: "components"}, {Name: "component", Key: map[string]string{"name": name}}}}
+}
+
+func TestHealthz(t *testing.T) {
+	conn, simulation, ds := newTestConn(t)
+	client := healthz.NewHealthzClient(conn)
+	ctx := context.Background()
+
+	response, err := client.Get(ctx, &healthz.GetRequest{Path: componentPath(config.ChassisName)})
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_HEALTHLY, response.Component.Status)
+	_, err = client.Get(ctx, &healthz.GetRequest{Path: &types.Path{Elem: []*types.PathElem{{Name: "components"}}}})
+	assert.True(t, errors.IsInvalid(errors.FromGRPC(err)))
+	_, err = client.Get(ctx, &healthz.GetRequest{Path: componentPath("fan-99")})
+	assert.True(t, errors.IsNotFound(errors.FromGRPC(err)))
+
+	// Failed fan makes the chassis unhealthy until its event is acknowledged and the fan restored
+	assert.NoError(t, simulation.FailPlatformComponent(ds.Device.ID, "fan-1"))
+	check, err := client.Check(ctx, &healthz.CheckRequest{Path: componentPath(config.ChassisName)})
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_UNHEALTHY, check.Status.Status)
+	list, err := client.List(ctx, &healthz.ListRequest{Path: componentPath(config.ChassisName)})
+	assert.NoError(t, err)
+	assert.Len(t, list.Status, 2)
+
+	response, err = client.Get(ctx, &healthz.GetRequest{Path: componentPath("fan-1")})
+	assert.NoError(t, err)
+	_, err = client.Acknowledge(ctx, &healthz.AcknowlegeRequest{Path: componentPath("fan-1"), Id: "bogus"})
+	assert.True(t, errors.IsNotFound(errors.FromGRPC(err)))
+	ack, err := client.Acknowledge(ctx, &healthz.AcknowlegeRequest{Path: componentPath("fan-1"), Id: response.Component.Id})
+	assert.NoError(t, err)
+	assert.True(t, ack.Status.Acknowledged)
+	list, err = client.List(ctx, &healthz.ListRequest{Path: componentPath(config.ChassisName)})
+	assert.NoError(t, err)
+	assert.Len(t, list.Status, 1)
+	list, err = client.List(ctx, &healthz.ListRequest{Path: componentPath(config.ChassisName), IncludeAcknowledged: true})
+	assert.NoError(t, err)
+	assert.Len(t, list.Status, 2)
+
+	assert.NoError(t, simulation.RestorePlatformComponent(ds.Device.ID, "fan-1"))
+	check, err = client.Check(ctx, &healthz.CheckRequest{Path: componentPath(config.ChassisName)})
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_HEALTHLY, check.Status.Status)
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	osapi "github.com/openconfig/gnoi/os"
+	"io"
+)
+
+// Number of bytes received between transfer progress reports
+const transferProgressInterval = 1024 * 1024
+
+// OSServer implements the gNOI OS API
+type OSServer struct {
+	deviceID   simapi.DeviceID
+	simulation *simulator.Simulation
+	deviceSim  *simulator.DeviceSimulator
+	osapi.UnimplementedOSServer
+}
+
+// NewOSServer creates a new gNOI OS API server
+func NewOSServer(deviceID simapi.DeviceID, simulation *simulator.Simulation) *OSServer {
+	sim, err := simulation.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return nil
+	}
+	return &OSServer{
+		deviceID:   deviceID,
+		simulation: simulation,
+		deviceSim:  sim,
+	}
+}
+
+// Install receives the image of the requested software version, unless already installed, and installs it
+func (s OSServer) Install(server osapi.OS_InstallServer) error {
+	request, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	transfer := request.GetTransferRequest()
+	if transfer == nil {
+		return errors.Status(errors.NewInvalid("transfer request expected")).Err()
+	}
+	log.Infof("Device %s: Received OS install request: %s", s.deviceID, transfer.Version)
+	if transfer.StandbySupervisor {
+		return sendInstallError(server, osapi.InstallError_UNSPECIFIED, "no standby supervisor")
+	}
+	if s.deviceSim.IsSoftwareInstalled(transfer.Version) {
+		return sendValidated(server, transfer.Version)
+	}
+	if err = server.Send(&osapi.InstallResponse{Response: &osapi.InstallResponse_TransferReady{TransferReady: &osapi.TransferReady{}}}); err != nil {
+		return err
+	}
+
+	contents := make([]byte, 0)
+	reported := 0
+	for {
+		request, err = server.Recv()
+		if err == io.EOF {
+			return errors.Status(errors.NewInvalid("transfer end not received")).Err()
+		} else if err != nil {
+			return err
+		}
+
+		switch r := request.Request.(type) {
+		case *osapi.InstallRequest_TransferContent:
+			contents = append(contents, r.TransferContent...)
+			if len(contents)-reported >= transferProgressInterval {
+				reported = len(contents)
+				if err = server.Send(&osapi.InstallResponse{Response: &osapi.InstallResponse_TransferProgress{
+					TransferProgress: &osapi.TransferProgress{BytesReceived: uint64(reported)},
+				}}); err != nil {
+					return err
+				}
+			}
+		case *osapi.InstallRequest_TransferEnd:
+			if err = s.deviceSim.InstallSoftware(transfer.Version, contents); err != nil {
+				return sendInstallError(server, osapi.InstallError_PARSE_FAIL, err.Error())
+			}
+			return sendValidated(server, transfer.Version)
+		default:
+			return errors.Status(errors.NewInvalid("transfer content or end expected")).Err()
+		}
+	}
+}
+
+// Sends the response validating the installed software version
+func sendValidated(server osapi.OS_InstallServer, version string) error {
+	return server.Send(&osapi.InstallResponse{Response: &osapi.InstallResponse_Validated{
+		Validated: &osapi.Validated{Version: version, Description: fmt.Sprintf("fabric-sim %s", version)},
+	}})
+}
+
+// Sends the response reporting the given install error
+func sendInstallError(server osapi.OS_InstallServer, errorType osapi.InstallError_Type, detail string) error {
+	return server.Send(&osapi.InstallResponse{Response: &osapi.InstallResponse_InstallError{
+		InstallError: &osapi.InstallError{Type: errorType, Detail: detail},
+	}})
+}
+
+// Activate activates the requested installed software version on the next reboot and, unless requested otherwise,
+// reboots the device
+func (s OSServer) Activate(ctx context.Context, request *osapi.ActivateRequest) (*osapi.ActivateResponse, error) {
+	log.Infof("Device %s: Received OS activate request: %s", s.deviceID, request.Version)
+	if request.StandbySupervisor {
+		return &osapi.ActivateResponse{Response: &osapi.ActivateResponse_ActivateError{
+			ActivateError: &osapi.ActivateError{Type: osapi.ActivateError_UNSPECIFIED, Detail: "no standby supervisor"},
+		}}, nil
+	}
+	if err := s.deviceSim.ActivateSoftware(request.Version, !request.NoReboot); err != nil {
+		if errors.IsNotFound(err) {
+			return &osapi.ActivateResponse{Response: &osapi.ActivateResponse_ActivateError{
+				ActivateError: &osapi.ActivateError{Type: osapi.ActivateError_NON_EXISTENT_VERSION, Detail: err.Error()},
+			}}, nil
+		}
+		return nil, errors.Status(err).Err()
+	}
+	return &osapi.ActivateResponse{Response: &osapi.ActivateResponse_ActivateOk{ActivateOk: &osapi.ActivateOK{}}}, nil
+}
+
+// Verify returns the running software version, along with the reason why the last activation failed, if it did
+func (s OSServer) Verify(ctx context.Context, request *osapi.VerifyRequest) (*osapi.VerifyResponse, error) {
+	log.Debugf("Device %s: Received OS verify request", s.deviceID)
+	status := s.deviceSim.GetUpgradeStatus()
+	return &osapi.VerifyResponse{Version: status.RunningVersion, ActivationFailMessage: status.FailureReason}, nil
+}
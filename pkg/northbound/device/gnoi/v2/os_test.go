@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"context"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	osapi "github.com/openconfig/gnoi/os"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOSInstall(t *testing.T) {
+	conn, _, ds := newTestConn(t)
+	client := osapi.NewOSClient(conn)
+	ctx := context.Background()
+
+	transfer := func(version string, standby bool) *osapi.InstallRequest {
+		return &osapi.InstallRequest{Request: &osapi.InstallRequest_TransferRequest{
+			TransferRequest: &osapi.TransferRequest{Version: version, StandbySupervisor: standby}}}
+	}
+	content := func(b []byte) *osapi.InstallRequest {
+		return &osapi.InstallRequest{Request: &osapi.InstallRequest_TransferContent{TransferContent: b}}
+	}
+	end := &osapi.InstallRequest{Request: &osapi.InstallRequest_TransferEnd{TransferEnd: &osapi.TransferEnd{}}}
+
+	// Image is transferred in chunks, with progress reported every so many bytes, and then validated
+	stream, err := client.Install(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(transfer("2.0.0", false)))
+	response, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.NotNil(t, response.GetTransferReady())
+	chunk := make([]byte, transferProgressInterval/2)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, stream.Send(content(chunk)))
+	}
+	assert.NoError(t, stream.Send(end))
+	response, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(transferProgressInterval), response.GetTransferProgress().GetBytesReceived())
+	response, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "2.0.0", response.GetValidated().GetVersion())
+	assert.True(t, ds.IsSoftwareInstalled("2.0.0"))
+
+	// Already installed version is validated right away
+	stream, err = client.Install(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(transfer("2.0.0", false)))
+	response, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "2.0.0", response.GetValidated().GetVersion())
+
+	// Standby supervisor and empty images are reported as install errors
+	stream, err = client.Install(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(transfer("3.0.0", true)))
+	response, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, osapi.InstallError_UNSPECIFIED, response.GetInstallError().GetType())
+
+	stream, err = client.Install(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(transfer("3.0.0", false)))
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(end))
+	response, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, osapi.InstallError_PARSE_FAIL, response.GetInstallError().GetType())
+	assert.False(t, ds.IsSoftwareInstalled("3.0.0"))
+}
+
+func TestOSActivateAndVerify(t *testing.T) {
+	conn, _, ds := newTestConn(t)
+	client := osapi.NewOSClient(conn)
+	ctx := context.Background()
+	assert.NoError(t, ds.InstallSoftware("2.0.0", []byte("fabric-sim software image")))
+
+	response, err := client.Activate(ctx, &osapi.ActivateRequest{Version: "4.0.0", NoReboot: true})
+	assert.NoError(t, err)
+	assert.Equal(t, osapi.ActivateError_NON_EXISTENT_VERSION, response.GetActivateError().GetType())
+	response, err = client.Activate(ctx, &osapi.ActivateRequest{Version: "2.0.0", StandbySupervisor: true})
+	assert.NoError(t, err)
+	assert.Equal(t, osapi.ActivateError_UNSPECIFIED, response.GetActivateError().GetType())
+
+	// Activation without reboot leaves the running version as is until the next reboot
+	response, err = client.Activate(ctx, &osapi.ActivateRequest{Version: "2.0.0", NoReboot: true})
+	assert.NoError(t, err)
+	assert.NotNil(t, response.GetActivateOk())
+	assert.Equal(t, "2.0.0", ds.GetUpgradeStatus().PendingVersion)
+	verify, err := client.Verify(ctx, &osapi.VerifyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, config.DefaultSoftwareVersion, verify.Version)
+	assert.Empty(t, verify.ActivationFailMessage)
+}
@@ -2,7 +2,7 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-// Package gnoi implements the simulated gNOI System service
+// Package gnoi implements the simulated gNOI System, File, Healthz and OS services
 package gnoi
 
 import (
@@ -12,12 +12,13 @@ import (
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	gnoiapi "github.com/openconfig/gnoi/system"
+	"io"
 	"time"
 )
 
 var log = logging.GetLogger("northbound", "device", "gnoi")
 
-// Server implements the P4Runtime API
+// Server implements the gNOI System API
 type Server struct {
 	deviceID   simapi.DeviceID
 	simulation *simulator.Simulation
@@ -42,14 +43,22 @@ func notImplemented() error {
 	return errors.Status(errors.NewNotSupported("method not supported")).Err()
 }
 
-// Ping is not implemented
+// Ping streams the replies to echo requests sent from the device to the requested destination host
 func (s Server) Ping(request *gnoiapi.PingRequest, server gnoiapi.System_PingServer) error {
-	return notImplemented()
+	log.Infof("Device %s: Received ping request: %s", s.deviceID, request.Destination)
+	if err := s.deviceSim.Ping(server.Context(), request, server.Send); err != nil {
+		return errors.Status(err).Err()
+	}
+	return nil
 }
 
-// Traceroute is not implemented
+// Traceroute streams the responses from each hop along the route from the device to the requested destination host
 func (s Server) Traceroute(request *gnoiapi.TracerouteRequest, server gnoiapi.System_TracerouteServer) error {
-	return notImplemented()
+	log.Infof("Device %s: Received traceroute request: %s", s.deviceID, request.Destination)
+	if err := s.deviceSim.Traceroute(server.Context(), request, server.Send); err != nil {
+		return errors.Status(err).Err()
+	}
+	return nil
 }
 
 // Time returns device's time since start of epoch, expressed in nanoseconds
@@ -58,9 +67,41 @@ func (s Server) Time(ctx context.Context, request *gnoiapi.TimeRequest) (*gnoiap
 	return &gnoiapi.TimeResponse{Time: uint64(time.Now().UnixNano())}, nil
 }
 
-// SetPackage is not implemented
+// SetPackage receives a software package, verifies it against its hash and, if requested, schedules its activation
+// on the next reboot of the device
 func (s Server) SetPackage(server gnoiapi.System_SetPackageServer) error {
-	return notImplemented()
+	var pkg *gnoiapi.Package
+	contents := make([]byte, 0)
+	for {
+		request, err := server.Recv()
+		if err == io.EOF {
+			return errors.Status(errors.NewInvalid("package hash not received")).Err()
+		} else if err != nil {
+			return err
+		}
+
+		switch r := request.Request.(type) {
+		case *gnoiapi.SetPackageRequest_Package:
+			if pkg != nil {
+				return errors.Status(errors.NewInvalid("package already received")).Err()
+			}
+			pkg = r.Package
+			log.Infof("Device %s: Receiving package %s", s.deviceID, pkg.Filename)
+		case *gnoiapi.SetPackageRequest_Contents:
+			if pkg == nil {
+				return errors.Status(errors.NewInvalid("package contents received before package")).Err()
+			}
+			contents = append(contents, r.Contents...)
+		case *gnoiapi.SetPackageRequest_Hash:
+			if pkg == nil {
+				return errors.Status(errors.NewInvalid("package hash received before package")).Err()
+			}
+			if err = s.deviceSim.SetPackage(pkg, contents, r.Hash); err != nil {
+				return errors.Status(err).Err()
+			}
+			return server.SendAndClose(&gnoiapi.SetPackageResponse{})
+		}
+	}
 }
 
 // SwitchControlProcessor is not implemented
@@ -68,19 +109,31 @@ func (s Server) SwitchControlProcessor(ctx context.Context, request *gnoiapi.Swi
 	return nil, notImplemented()
 }
 
-// Reboot is not implemented
+// Reboot schedules a reboot of the device using the requested method after the requested delay
 func (s Server) Reboot(ctx context.Context, request *gnoiapi.RebootRequest) (*gnoiapi.RebootResponse, error) {
-	return nil, notImplemented()
+	log.Infof("Device %s: Received reboot request: %s", s.deviceID, request.Message)
+	if len(request.Subcomponents) > 0 {
+		return nil, errors.Status(errors.NewNotSupported("reboot of subcomponents not supported")).Err()
+	}
+	if err := s.deviceSim.Reboot(request.Method, time.Duration(request.Delay), request.Message); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &gnoiapi.RebootResponse{}, nil
 }
 
-// RebootStatus is not implemented
+// RebootStatus returns the status of the pending reboot of the device, if any
 func (s Server) RebootStatus(ctx context.Context, request *gnoiapi.RebootStatusRequest) (*gnoiapi.RebootStatusResponse, error) {
-	return nil, notImplemented()
+	log.Debugf("Device %s: Received reboot status request", s.deviceID)
+	return s.deviceSim.RebootStatus(), nil
 }
 
-// CancelReboot is not implemented
+// CancelReboot cancels the pending reboot of the device
 func (s Server) CancelReboot(ctx context.Context, request *gnoiapi.CancelRebootRequest) (*gnoiapi.CancelRebootResponse, error) {
-	return nil, notImplemented()
+	log.Infof("Device %s: Received cancel reboot request: %s", s.deviceID, request.Message)
+	if err := s.deviceSim.CancelReboot(); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &gnoiapi.CancelRebootResponse{}, nil
 }
 
 // KillProcess is not implemented
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gnoi
+
+import (
+	"context"
+	"crypto/sha256"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	fileapi "github.com/openconfig/gnoi/file"
+	"github.com/openconfig/gnoi/healthz"
+	osapi "github.com/openconfig/gnoi/os"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	"github.com/openconfig/gnoi/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+	"time"
+)
+
+type testAgent struct {
+}
+
+func (t testAgent) Start(simulation *simulator.Simulation, deviceSim *simulator.DeviceSimulator) error {
+	return nil
+}
+
+func (t testAgent) Stop(mode simapi.StopMode) error {
+	return nil
+}
+
+// Serves the gNOI services of the first device of the custom topology over an in-memory connection for the
+// duration of the test; returns the client connection, the simulation and the simulator of the device
+func newTestConn(t *testing.T) (*grpc.ClientConn, *simulator.Simulation, *simulator.DeviceSimulator) {
+	topology := &topo.Topology{}
+	assert.NoError(t, topo.LoadTopologyFile("../../../../../topologies/custom.yaml", topology))
+	simulation := simulator.NewSimulation()
+	ds, err := simulation.AddDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), &testAgent{})
+	assert.NoError(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	gnoiapi.RegisterSystemServer(server, NewServer(ds.Device.ID, simulation))
+	fileapi.RegisterFileServer(server, NewFileServer(ds.Device.ID, simulation))
+	healthz.RegisterHealthzServer(server, NewHealthzServer(ds.Device.ID, simulation))
+	osapi.RegisterOSServer(server, NewOSServer(ds.Device.ID, simulation))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn, simulation, ds
+}
+
+func TestSetPackage(t *testing.T) {
+	conn, _, ds := newTestConn(t)
+	client := gnoiapi.NewSystemClient(conn)
+	ctx := context.Background()
+
+	contents := []byte("fabric-sim software image")
+	sum := sha256.Sum256(contents)
+	setPackage := func(requests ...*gnoiapi.SetPackageRequest) error {
+		stream, err := client.SetPackage(ctx)
+		assert.NoError(t, err)
+		for _, request := range requests {
+			assert.NoError(t, stream.Send(request))
+		}
+		_, err = stream.CloseAndRecv()
+		return errors.FromGRPC(err)
+	}
+	pkg := func(filename string) *gnoiapi.SetPackageRequest {
+		return &gnoiapi.SetPackageRequest{Request: &gnoiapi.SetPackageRequest_Package{
+			Package: &gnoiapi.Package{Filename: filename, Version: "2.0.0", Activate: true}}}
+	}
+	chunk := func(b []byte) *gnoiapi.SetPackageRequest {
+		return &gnoiapi.SetPackageRequest{Request: &gnoiapi.SetPackageRequest_Contents{Contents: b}}
+	}
+	hash := func(h []byte) *gnoiapi.SetPackageRequest {
+		return &gnoiapi.SetPackageRequest{Request: &gnoiapi.SetPackageRequest_Hash{
+			Hash: &types.HashType{Method: types.HashType_SHA256, Hash: h}}}
+	}
+
+	// Package is received in chunks and verified against its hash
+	assert.NoError(t, setPackage(pkg("/tmp/os.bin"), chunk(contents[:10]), chunk(contents[10:]), hash(sum[:])))
+	assert.Equal(t, "2.0.0", ds.GetUpgradeStatus().PendingVersion)
+	file, err := ds.GetFile("/tmp/os.bin")
+	assert.NoError(t, err)
+	assert.Equal(t, contents, file.Contents)
+
+	// Out of order, incomplete, corrupt or misnamed packages are rejected
+	assert.True(t, errors.IsInvalid(setPackage(chunk(contents), hash(sum[:]))))
+	assert.True(t, errors.IsInvalid(setPackage(pkg("/tmp/os.bin"), pkg("/tmp/os.bin"))))
+	assert.True(t, errors.IsInvalid(setPackage(pkg("/tmp/os.bin"), chunk(contents))))
+	assert.True(t, errors.IsInvalid(setPackage(pkg("/tmp/os.bin"), chunk(contents), hash([]byte("bad")))))
+	assert.True(t, errors.IsInvalid(setPackage(pkg("os.bin"), chunk(contents), hash(sum[:]))))
+}
+
+func TestReboot(t *testing.T) {
+	conn, _, _ := newTestConn(t)
+	client := gnoiapi.NewSystemClient(conn)
+	ctx := context.Background()
+
+	before := time.Now()
+	response, err := client.Time(ctx, &gnoiapi.TimeRequest{})
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(response.Time), before.UnixNano())
+
+	// Delayed reboot is pending until cancelled
+	_, err = client.Reboot(ctx, &gnoiapi.RebootRequest{Method: gnoiapi.RebootMethod_COLD, Delay: uint64(time.Hour), Message: "test"})
+	assert.NoError(t, err)
+	status, err := client.RebootStatus(ctx, &gnoiapi.RebootStatusRequest{})
+	assert.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.Equal(t, "test", status.Reason)
+	assert.NotZero(t, status.Wait)
+	_, err = client.CancelReboot(ctx, &gnoiapi.CancelRebootRequest{})
+	assert.NoError(t, err)
+	status, err = client.RebootStatus(ctx, &gnoiapi.RebootStatusRequest{})
+	assert.NoError(t, err)
+	assert.False(t, status.Active)
+	_, err = client.CancelReboot(ctx, &gnoiapi.CancelRebootRequest{})
+	assert.True(t, errors.IsNotFound(errors.FromGRPC(err)))
+
+	// Reboot of subcomponents and unimplemented methods are not supported
+	_, err = client.Reboot(ctx, &gnoiapi.RebootRequest{Subcomponents: []*types.Path{{Elem: []*types.PathElem{{Name: "components"}}}}})
+	assert.True(t, errors.IsNotSupported(errors.FromGRPC(err)))
+	_, err = client.KillProcess(ctx, &gnoiapi.KillProcessRequest{})
+	assert.True(t, errors.IsNotSupported(errors.FromGRPC(err)))
+}
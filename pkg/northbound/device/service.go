@@ -7,15 +7,17 @@ package device
 
 import (
 	"context"
-	"fmt"
+	gnmisim "github.com/onosproject/fabric-sim/pkg/northbound/device/gnmi"
 	gnoisim "github.com/onosproject/fabric-sim/pkg/northbound/device/gnoi/v2"
 	"github.com/onosproject/fabric-sim/pkg/northbound/device/p4runtime/v1"
 	"github.com/onosproject/fabric-sim/pkg/simulator"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-lib-go/pkg/northbound"
-	"github.com/onosproject/onos-net-lib/pkg/gnmiserver"
 	gnmiapi "github.com/openconfig/gnmi/proto/gnmi"
+	fileapi "github.com/openconfig/gnoi/file"
+	"github.com/openconfig/gnoi/healthz"
+	osapi "github.com/openconfig/gnoi/os"
 	gnoiapi "github.com/openconfig/gnoi/system"
 	p4rtapi "github.com/p4lang/p4runtime/go/p4/v1"
 	"google.golang.org/grpc"
@@ -32,13 +34,15 @@ type Service struct {
 	deviceSim  *simulator.DeviceSimulator
 }
 
-// Register registers the gNMI and P4Runtime with the given gRPC server
+// Register registers the gNMI, gNOI and P4Runtime services with the given gRPC server
 func (s Service) Register(r *grpc.Server) {
-	gnmiServer := gnmiserver.NewGNMIServer(&s.deviceSim.GNMIConfigurable, fmt.Sprintf("Device %s", s.deviceID))
-	gnmiapi.RegisterGNMIServer(r, gnmiServer)
+	gnmiapi.RegisterGNMIServer(r, gnmisim.NewServer(s.deviceID, s.simulation))
 	gnoiapi.RegisterSystemServer(r, gnoisim.NewServer(s.deviceID, s.simulation))
+	fileapi.RegisterFileServer(r, gnoisim.NewFileServer(s.deviceID, s.simulation))
+	healthz.RegisterHealthzServer(r, gnoisim.NewHealthzServer(s.deviceID, s.simulation))
+	osapi.RegisterOSServer(r, gnoisim.NewOSServer(s.deviceID, s.simulation))
 	p4rtapi.RegisterP4RuntimeServer(r, p4runtime.NewServer(s.deviceID, s.simulation))
-	log.Debugf("Device %s: P4Runtime and gNMI registered", s.deviceID)
+	log.Debugf("Device %s: P4Runtime, gNMI and gNOI registered", s.deviceID)
 }
 
 // NewAgent creates a new simulated device agent
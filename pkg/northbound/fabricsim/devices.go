@@ -6,6 +6,7 @@ package fabricsim
 
 import (
 	"context"
+	"github.com/onosproject/fabric-sim/api/fabricsimext"
 	"github.com/onosproject/fabric-sim/pkg/northbound/device"
 	"github.com/onosproject/fabric-sim/pkg/simulator"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
@@ -114,3 +115,79 @@ func (s *Server) EmitLLDPPacket(ctx context.Context, request *simapi.EmitLLDPPac
 	sim.EmitLLDPPacket(request.Packet, request.PortID)
 	return &simapi.EmitLLDPPacketResponse{}, nil
 }
+
+// SetPortFaults injects the given faults into the specified port; absent faults stop the injection
+func (s *Server) SetPortFaults(ctx context.Context, request *fabricsimext.SetPortFaultsRequest) (*fabricsimext.SetPortFaultsResponse, error) {
+	faults, err := portFaults(request.Faults)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	if err := s.simulation.SetPortFaults(simapi.PortID(request.Id), faults); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetPortFaultsResponse{}, nil
+}
+
+// Converts the given port faults into their simulator counterpart; nil faults yield nil
+func portFaults(f *fabricsimext.PortFaults) (*simulator.PortFaults, error) {
+	if f == nil {
+		return nil, nil
+	}
+	return simulator.NewPortFaults(f.FcsErrors, f.InErrors, f.InDiscards, f.OutDiscards, f.DownThreshold)
+}
+
+// FailPlatformComponent fails the named fan or power supply of the specified device
+func (s *Server) FailPlatformComponent(ctx context.Context, request *fabricsimext.FailPlatformComponentRequest) (*fabricsimext.FailPlatformComponentResponse, error) {
+	if err := s.simulation.FailPlatformComponent(simapi.DeviceID(request.Id), request.Name); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.FailPlatformComponentResponse{}, nil
+}
+
+// RestorePlatformComponent restores the named fan or power supply of the specified device
+func (s *Server) RestorePlatformComponent(ctx context.Context, request *fabricsimext.RestorePlatformComponentRequest) (*fabricsimext.RestorePlatformComponentResponse, error) {
+	if err := s.simulation.RestorePlatformComponent(simapi.DeviceID(request.Id), request.Name); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.RestorePlatformComponentResponse{}, nil
+}
+
+// SetDeviceOverheat raises the temperature of all sensors of the specified device by the given number of degrees
+func (s *Server) SetDeviceOverheat(ctx context.Context, request *fabricsimext.SetDeviceOverheatRequest) (*fabricsimext.SetDeviceOverheatResponse, error) {
+	if err := s.simulation.SetDeviceOverheat(simapi.DeviceID(request.Id), request.Degrees); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetDeviceOverheatResponse{}, nil
+}
+
+// BreakoutPort splits the specified physical port into child ports per the given breakout mode, e.g. 4x25Gbps
+func (s *Server) BreakoutPort(ctx context.Context, request *fabricsimext.BreakoutPortRequest) (*fabricsimext.BreakoutPortResponse, error) {
+	numBreakouts, speed, err := simulator.ParseBreakout(request.Mode)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	if err := s.simulation.BreakoutPort(simapi.PortID(request.Id), numBreakouts, speed); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.BreakoutPortResponse{}, nil
+}
+
+// AddLAG adds a link aggregation group of the specified ports to the specified device
+func (s *Server) AddLAG(ctx context.Context, request *fabricsimext.AddLAGRequest) (*fabricsimext.AddLAGResponse, error) {
+	members := make([]simapi.PortID, 0, len(request.Members))
+	for _, member := range request.Members {
+		members = append(members, simapi.PortID(member))
+	}
+	if err := s.simulation.AddLAG(simapi.DeviceID(request.Id), request.Name, members, int(request.MinLinks)); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.AddLAGResponse{}, nil
+}
+
+// RemoveLAG removes the named link aggregation group from the specified device
+func (s *Server) RemoveLAG(ctx context.Context, request *fabricsimext.RemoveLAGRequest) (*fabricsimext.RemoveLAGResponse, error) {
+	if err := s.simulation.RemoveLAG(simapi.DeviceID(request.Id), request.Name); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.RemoveLAGResponse{}, nil
+}
@@ -6,10 +6,63 @@ package fabricsim
 
 import (
 	"context"
+	"fmt"
+	"github.com/onosproject/fabric-sim/api/fabricsimext"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"time"
 )
 
 // GetIOStats returns a list of aggregate I/O time-series statistics accumulated by the simulator.
 func (s *Server) GetIOStats(ctx context.Context, request *simapi.GetIOStatsRequest) (*simapi.GetIOStatsResponse, error) {
 	return &simapi.GetIOStatsResponse{Stats: s.simulation.Collector.GetIOStats()}, nil
 }
+
+// SetTrafficMatrix sets the traffic matrix which drives the port counters of all devices; empty matrix clears it
+func (s *Server) SetTrafficMatrix(ctx context.Context, request *fabricsimext.SetTrafficMatrixRequest) (*fabricsimext.SetTrafficMatrixResponse, error) {
+	flows := make([]*simulator.TrafficFlow, 0, len(request.Flows))
+	for _, f := range request.Flows {
+		flow, err := simulator.NewTrafficFlow(f.Src, f.Dst, f.Rate, int(f.PacketSize))
+		if err != nil {
+			return nil, errors.Status(err).Err()
+		}
+		flows = append(flows, flow)
+	}
+	if err := s.simulation.SetTrafficMatrix(flows); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetTrafficMatrixResponse{}, nil
+}
+
+// GetTrafficMatrix returns the traffic matrix which drives the port counters of all devices
+func (s *Server) GetTrafficMatrix(ctx context.Context, request *fabricsimext.GetTrafficMatrixRequest) (*fabricsimext.GetTrafficMatrixResponse, error) {
+	matrix := s.simulation.GetTrafficMatrix()
+	flows := make([]*fabricsimext.TrafficFlow, 0, len(matrix))
+	for _, flow := range matrix {
+		flows = append(flows, &fabricsimext.TrafficFlow{
+			Src:        string(flow.Src),
+			Dst:        string(flow.Dst),
+			Rate:       fmt.Sprintf("%dbps", flow.Rate),
+			PacketSize: uint32(flow.PacketSize),
+		})
+	}
+	return &fabricsimext.GetTrafficMatrixResponse{Flows: flows}, nil
+}
+
+// SetRebootDowntime sets the time for which devices stay unreachable during a cold reboot
+func (s *Server) SetRebootDowntime(ctx context.Context, request *fabricsimext.SetRebootDowntimeRequest) (*fabricsimext.SetRebootDowntimeResponse, error) {
+	downtime, err := time.ParseDuration(request.Downtime)
+	if err != nil {
+		return nil, errors.Status(errors.NewInvalid("invalid reboot downtime %s", request.Downtime)).Err()
+	}
+	if err := s.simulation.SetRebootDowntime(downtime); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetRebootDowntimeResponse{}, nil
+}
+
+// GetRebootDowntime returns the time for which devices stay unreachable during a cold reboot
+func (s *Server) GetRebootDowntime(ctx context.Context, request *fabricsimext.GetRebootDowntimeRequest) (*fabricsimext.GetRebootDowntimeResponse, error) {
+	return &fabricsimext.GetRebootDowntimeResponse{Downtime: s.simulation.GetRebootDowntime().String()}, nil
+}
@@ -6,6 +6,8 @@ package fabricsim
 
 import (
 	"context"
+	"github.com/onosproject/fabric-sim/api/fabricsimext"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 )
@@ -31,7 +33,7 @@ func (s *Server) GetHost(ctx context.Context, request *simapi.GetHostRequest) (*
 
 // AddHost creates and registers the specified simulated host
 func (s *Server) AddHost(ctx context.Context, request *simapi.AddHostRequest) (*simapi.AddHostResponse, error) {
-	if _, err := s.simulation.AddHostSimulator(request.Host); err != nil {
+	if _, err := s.simulation.AddHostSimulator(request.Host, nil); err != nil {
 		return nil, errors.Status(err).Err()
 	}
 	return &simapi.AddHostResponse{}, nil
@@ -52,3 +54,56 @@ func (s *Server) EmitARPs(ctx context.Context, request *simapi.EmitARPsRequest)
 	}
 	return &simapi.EmitARPsResponse{}, nil
 }
+
+// MoveNetworkInterface moves the specified host NIC to a different device port
+func (s *Server) MoveNetworkInterface(ctx context.Context, request *fabricsimext.MoveNetworkInterfaceRequest) (*fabricsimext.MoveNetworkInterfaceResponse, error) {
+	if err := s.simulation.MoveNetworkInterface(simapi.HostID(request.Id), request.MacAddress, simapi.PortID(request.PortId)); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.MoveNetworkInterfaceResponse{}, nil
+}
+
+// JoinMulticastGroup has the specified host join the given multicast group
+func (s *Server) JoinMulticastGroup(ctx context.Context, request *fabricsimext.JoinMulticastGroupRequest) (*fabricsimext.JoinMulticastGroupResponse, error) {
+	if err := s.simulation.JoinMulticastGroup(simapi.HostID(request.Id), request.Group); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.JoinMulticastGroupResponse{}, nil
+}
+
+// LeaveMulticastGroup has the specified host leave the given multicast group
+func (s *Server) LeaveMulticastGroup(ctx context.Context, request *fabricsimext.LeaveMulticastGroupRequest) (*fabricsimext.LeaveMulticastGroupResponse, error) {
+	if err := s.simulation.LeaveMulticastGroup(simapi.HostID(request.Id), request.Group); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.LeaveMulticastGroupResponse{}, nil
+}
+
+// SetHostBehavior sets the behavior of the specified host; absent behavior yields the default behavior
+func (s *Server) SetHostBehavior(ctx context.Context, request *fabricsimext.SetHostBehaviorRequest) (*fabricsimext.SetHostBehaviorResponse, error) {
+	behavior, err := hostBehavior(request.Behavior)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	if err := s.simulation.SetHostBehavior(simapi.HostID(request.Id), behavior); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetHostBehaviorResponse{}, nil
+}
+
+// Converts the given host behavior into its simulator counterpart; nil behavior yields the default behavior
+func hostBehavior(b *fabricsimext.HostBehavior) (*simulator.HostBehavior, error) {
+	if b == nil {
+		b = &fabricsimext.HostBehavior{}
+	}
+	behavior, err := simulator.NewHostBehavior(b.Profile, b.Interval, int(b.BurstSize), b.ArpTargets)
+	if err != nil {
+		return nil, err
+	}
+	if err = behavior.SetIGMPVersion(int(b.IgmpVersion)); err != nil {
+		return nil, err
+	}
+	behavior.LACP = b.Lacp
+	behavior.LACPFastRate = b.LacpFastRate
+	return behavior, nil
+}
@@ -6,6 +6,8 @@ package fabricsim
 
 import (
 	"context"
+	"github.com/onosproject/fabric-sim/api/fabricsimext"
+	"github.com/onosproject/fabric-sim/pkg/simulator"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 )
@@ -44,3 +46,78 @@ func (s *Server) RemoveLink(ctx context.Context, request *simapi.RemoveLinkReque
 	}
 	return &simapi.RemoveLinkResponse{}, nil
 }
+
+// SetLinkImpairment sets the impairment of the specified link; absent impairment removes any impairment
+func (s *Server) SetLinkImpairment(ctx context.Context, request *fabricsimext.SetLinkImpairmentRequest) (*fabricsimext.SetLinkImpairmentResponse, error) {
+	var impairment *simulator.LinkImpairment
+	if i := request.Impairment; i != nil {
+		var err error
+		if impairment, err = simulator.NewLinkImpairment(i.Latency, i.Jitter, i.Loss, i.Duplication); err != nil {
+			return nil, errors.Status(err).Err()
+		}
+	}
+	if err := s.simulation.SetLinkImpairment(simapi.LinkID(request.Id), impairment); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetLinkImpairmentResponse{}, nil
+}
+
+// DisableLink disables the specified link using the given mode
+func (s *Server) DisableLink(ctx context.Context, request *fabricsimext.DisableLinkRequest) (*fabricsimext.DisableLinkResponse, error) {
+	if _, ok := fabricsimext.LinkDisableMode_name[int32(request.Mode)]; !ok {
+		return nil, errors.Status(errors.NewInvalid("invalid link disable mode %d", request.Mode)).Err()
+	}
+	if err := s.simulation.DisableLink(simapi.LinkID(request.Id), simulator.LinkDisableMode(request.Mode)); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.DisableLinkResponse{}, nil
+}
+
+// EnableLink enables the specified link
+func (s *Server) EnableLink(ctx context.Context, request *fabricsimext.EnableLinkRequest) (*fabricsimext.EnableLinkResponse, error) {
+	if err := s.simulation.EnableLink(simapi.LinkID(request.Id)); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.EnableLinkResponse{}, nil
+}
+
+// SetLinkFaults injects the given faults into the port at the receiving end of the specified link; absent faults
+// stop the injection
+func (s *Server) SetLinkFaults(ctx context.Context, request *fabricsimext.SetLinkFaultsRequest) (*fabricsimext.SetLinkFaultsResponse, error) {
+	faults, err := portFaults(request.Faults)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	if err := s.simulation.SetLinkFaults(simapi.LinkID(request.Id), faults); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.SetLinkFaultsResponse{}, nil
+}
+
+// FlapLinks makes each of the specified links flap according to the given schedule
+func (s *Server) FlapLinks(ctx context.Context, request *fabricsimext.FlapLinksRequest) (*fabricsimext.FlapLinksResponse, error) {
+	sd := request.Schedule
+	if sd == nil {
+		return nil, errors.Status(errors.NewInvalid("link flap schedule is required")).Err()
+	}
+	schedule, err := simulator.NewLinkFlapSchedule(int(sd.Count), sd.Period, sd.Down, sd.Delay)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	ids := make([]simapi.LinkID, 0, len(request.Ids))
+	for _, id := range request.Ids {
+		ids = append(ids, simapi.LinkID(id))
+	}
+	if err := s.simulation.FlapLinks(ids, schedule); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.FlapLinksResponse{}, nil
+}
+
+// StopFlappingLink stops the specified link from flapping
+func (s *Server) StopFlappingLink(ctx context.Context, request *fabricsimext.StopFlappingLinkRequest) (*fabricsimext.StopFlappingLinkResponse, error) {
+	if err := s.simulation.StopFlappingLink(simapi.LinkID(request.Id)); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &fabricsimext.StopFlappingLinkResponse{}, nil
+}
@@ -6,6 +6,7 @@
 package fabricsim
 
 import (
+	"github.com/onosproject/fabric-sim/api/fabricsimext"
 	"github.com/onosproject/fabric-sim/pkg/simulator"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
@@ -32,9 +33,13 @@ func (s Service) Register(r *grpc.Server) {
 		simulation: s.simulation,
 	}
 	simapi.RegisterFabricSimulatorServer(r, server)
+	fabricsimext.RegisterFabricSimulatorServer(r, server)
 	simapi.RegisterDeviceServiceServer(r, server)
+	fabricsimext.RegisterDeviceServiceServer(r, server)
 	simapi.RegisterLinkServiceServer(r, server)
+	fabricsimext.RegisterLinkServiceServer(r, server)
 	simapi.RegisterHostServiceServer(r, server)
+	fabricsimext.RegisterHostServiceServer(r, server)
 	log.Debug("Fabric API services registered")
 }
 
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-net-lib/pkg/configtree"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// AddAggregateInterface adds an aggregate interface with the given name, member ports and minimum number of
+// links under the given root configuration and marks the member interfaces with its aggregate ID
+func AddAggregateInterface(node *configtree.Node, name string, members []string, minLinks int) *configtree.Node {
+	interfaceNode := node.AddPath(fmt.Sprintf("interfaces/interface[name=%s]", name), nil)
+	interfaceNode.AddPath("config/name",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	interfaceNode.AddPath("config/type",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "ieee8023adLag"}})
+	interfaceNode.AddPath("config/enabled",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}})
+
+	for _, mode := range []string{"config", "state"} {
+		interfaceNode.AddPath(fmt.Sprintf("aggregation/%s/lag-type", mode),
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "STATIC"}})
+		interfaceNode.AddPath(fmt.Sprintf("aggregation/%s/min-links", mode),
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(minLinks)}})
+	}
+
+	elements := make([]*gnmi.TypedValue, 0, len(members))
+	for _, member := range members {
+		elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: member}})
+		for _, mode := range []string{"config", "state"} {
+			node.AddPath(fmt.Sprintf("interfaces/interface[name=%s]/ethernet/%s/aggregate-id", member, mode),
+				&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+		}
+	}
+	interfaceNode.AddPath("aggregation/state/member",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{Element: elements}}})
+	return interfaceNode
+}
+
+// RemoveAggregateInterface removes the aggregate interface with the given name and member ports from under
+// the given root configuration
+func RemoveAggregateInterface(node *configtree.Node, name string, members []string) {
+	node.DeletePath(fmt.Sprintf("interfaces/interface[name=%s]", name))
+	for _, member := range members {
+		for _, mode := range []string{"config", "state"} {
+			node.DeletePath(fmt.Sprintf("interfaces/interface[name=%s]/ethernet/%s/aggregate-id", member, mode))
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+)
+
+// Encodings lists the encodings in which the simulated device configuration can be retrieved
+var Encodings = []gnmi.Encoding{gnmi.Encoding_JSON, gnmi.Encoding_JSON_IETF, gnmi.Encoding_PROTO}
+
+// IsSupportedEncoding returns true if the given encoding is one of the supported encodings
+func IsSupportedEncoding(encoding gnmi.Encoding) bool {
+	for _, e := range Encodings {
+		if e == encoding {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeValue encodes the given typed value using the specified encoding; PROTO encoding yields a copy of the
+// value, which remains intact as the configuration changes; returns nil if the value cannot be encoded
+func EncodeValue(val *gnmi.TypedValue, encoding gnmi.Encoding) *gnmi.TypedValue {
+	if val == nil {
+		return nil
+	}
+	if encoding == gnmi.Encoding_PROTO {
+		return proto.Clone(val).(*gnmi.TypedValue)
+	}
+	ietf := encoding == gnmi.Encoding_JSON_IETF
+	b, err := json.Marshal(jsonValue(val, ietf))
+	if err != nil {
+		return nil
+	}
+	if ietf {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: b}}
+	}
+	return &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: b}}
+}
+
+// Returns the JSON representation of the given typed value; RFC 7951, used by JSON_IETF encoding, requires
+// 64-bit integers to be represented as strings
+func jsonValue(val *gnmi.TypedValue, ietf bool) interface{} {
+	switch v := val.Value.(type) {
+	case *gnmi.TypedValue_StringVal:
+		return v.StringVal
+	case *gnmi.TypedValue_BoolVal:
+		return v.BoolVal
+	case *gnmi.TypedValue_UintVal:
+		if ietf {
+			return strconv.FormatUint(v.UintVal, 10)
+		}
+		return v.UintVal
+	case *gnmi.TypedValue_IntVal:
+		if ietf {
+			return strconv.FormatInt(v.IntVal, 10)
+		}
+		return v.IntVal
+	case *gnmi.TypedValue_DoubleVal:
+		return v.DoubleVal
+	case *gnmi.TypedValue_FloatVal:
+		return v.FloatVal
+	case *gnmi.TypedValue_LeaflistVal:
+		elements := make([]interface{}, 0, len(v.LeaflistVal.GetElement()))
+		for _, element := range v.LeaflistVal.GetElement() {
+			elements = append(elements, jsonValue(element, ietf))
+		}
+		return elements
+	case *gnmi.TypedValue_JsonVal:
+		return json.RawMessage(v.JsonVal)
+	case *gnmi.TypedValue_JsonIetfVal:
+		return json.RawMessage(v.JsonIetfVal)
+	case *gnmi.TypedValue_AsciiVal:
+		return v.AsciiVal
+	case *gnmi.TypedValue_BytesVal:
+		return v.BytesVal
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const openConfigOrganization = "OpenConfig working group"
+
+// Models lists the OpenConfig models to which the simulated device configuration conforms
+var Models = []*gnmi.ModelData{
+	{Name: "openconfig-interfaces", Organization: openConfigOrganization, Version: "2.4.3"},
+	{Name: "openconfig-if-ethernet", Organization: openConfigOrganization, Version: "2.11.0"},
+	{Name: "openconfig-if-aggregate", Organization: openConfigOrganization, Version: "2.4.3"},
+	{Name: "openconfig-platform", Organization: openConfigOrganization, Version: "0.13.0"},
+	{Name: "openconfig-platform-port", Organization: openConfigOrganization, Version: "0.4.2"},
+	{Name: "openconfig-platform-transceiver", Organization: openConfigOrganization, Version: "0.8.0"},
+	{Name: "openconfig-platform-fan", Organization: openConfigOrganization, Version: "0.1.1"},
+	{Name: "openconfig-platform-psu", Organization: openConfigOrganization, Version: "0.2.1"},
+	{Name: "openconfig-platform-cpu", Organization: openConfigOrganization, Version: "0.1.1"},
+	{Name: "openconfig-qos", Organization: openConfigOrganization, Version: "0.11.1"},
+	{Name: "openconfig-system", Organization: openConfigOrganization, Version: "1.0.0"},
+}
+
+// IsSupportedModel returns true if the given model is one of the supported models; version is checked only if given
+func IsSupportedModel(model *gnmi.ModelData) bool {
+	for _, m := range Models {
+		if m.Name == model.Name && (len(model.Version) == 0 || m.Version == model.Version) {
+			return true
+		}
+	}
+	return false
+}
+
+// ModelOf returns the name of the model which defines the node at the given path; empty if none of the
+// supported models do
+func ModelOf(path *gnmi.Path) string {
+	elems := path.GetElem()
+	if len(elems) == 0 {
+		return ""
+	}
+	switch elems[0].Name {
+	case "interfaces":
+		if len(elems) > 2 {
+			switch elems[2].Name {
+			case "ethernet":
+				return "openconfig-if-ethernet"
+			case "aggregation":
+				return "openconfig-if-aggregate"
+			}
+		}
+		return "openconfig-interfaces"
+	case "components":
+		if len(elems) > 2 {
+			switch elems[2].Name {
+			case "port":
+				return "openconfig-platform-port"
+			case "transceiver":
+				return "openconfig-platform-transceiver"
+			case "fan":
+				return "openconfig-platform-fan"
+			case "power-supply":
+				return "openconfig-platform-psu"
+			case "cpu":
+				return "openconfig-platform-cpu"
+			}
+		}
+		return "openconfig-platform"
+	case "qos":
+		return "openconfig-qos"
+	case "system":
+		return "openconfig-system"
+	}
+	return ""
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-net-lib/pkg/configtree"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Names of the singular platform components
+const (
+	ChassisName  = "chassis"
+	LinecardName = "linecard-1"
+	CPUName      = "cpu-0"
+)
+
+// Platform carries the state of the platform components of a device, i.e. its chassis, linecard, fans, power
+// supplies, temperature sensors, CPU and memory
+type Platform struct {
+	SerialNo string
+	Fans     []*Fan
+	PSUs     []*PSU
+	Sensors  []*TemperatureSensor
+	// CPUUtilization is in percent
+	CPUUtilization uint8
+	// MemoryAvailable, i.e. the installed memory, and MemoryUtilized are in bytes
+	MemoryAvailable uint64
+	MemoryUtilized  uint64
+}
+
+// Fan carries the state of a single fan
+type Fan struct {
+	Name   string
+	Failed bool
+	// Speed is in RPM
+	Speed uint64
+}
+
+// PSU carries the state of a single power supply unit
+type PSU struct {
+	Name   string
+	Failed bool
+	// Capacity and OutputPower are in watts
+	Capacity    float64
+	OutputPower float64
+}
+
+// TemperatureSensor carries the state of a single temperature sensor
+type TemperatureSensor struct {
+	Name string
+	// Temperature and AlarmThreshold are in degrees Celsius
+	Temperature    float64
+	AlarmThreshold float64
+}
+
+// Alarm returns true if the temperature is past the alarm threshold
+func (s *TemperatureSensor) Alarm() bool {
+	return s.Temperature > s.AlarmThreshold
+}
+
+// NewPlatform creates the platform components of a healthy device with the given chassis serial number
+func NewPlatform(serialNo string) *Platform {
+	p := &Platform{
+		SerialNo:        serialNo,
+		CPUUtilization:  10,
+		MemoryAvailable: 16 << 30,
+		MemoryUtilized:  4 << 30,
+	}
+	for i := 1; i <= 4; i++ {
+		p.Fans = append(p.Fans, &Fan{Name: fmt.Sprintf("fan-%d", i), Speed: 8000})
+	}
+	for i := 1; i <= 2; i++ {
+		p.PSUs = append(p.PSUs, &PSU{Name: fmt.Sprintf("psu-%d", i), Capacity: 1100, OutputPower: 150})
+	}
+	p.Sensors = []*TemperatureSensor{
+		{Name: "temp-inlet", Temperature: 25, AlarmThreshold: 55},
+		{Name: "temp-asic", Temperature: 45, AlarmThreshold: 95},
+		{Name: "temp-cpu", Temperature: 40, AlarmThreshold: 90},
+	}
+	return p
+}
+
+// AddPlatformComponents adds the platform components under the given root configuration
+func AddPlatformComponents(node *configtree.Node, p *Platform) {
+	addComponent(node, ChassisName, "CHASSIS", "")
+	node.AddPath(fmt.Sprintf("components/component[name=%s]/state/part-no", ChassisName),
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "FS-CHASSIS"}})
+	addComponent(node, LinecardName, "LINECARD", ChassisName)
+	addComponent(node, CPUName, "CPU", LinecardName)
+	for _, fan := range p.Fans {
+		addComponent(node, fan.Name, "FAN", ChassisName)
+	}
+	for _, psu := range p.PSUs {
+		addComponent(node, psu.Name, "POWER_SUPPLY", ChassisName)
+	}
+	for _, sensor := range p.Sensors {
+		addComponent(node, sensor.Name, "SENSOR", ChassisName)
+	}
+	UpdatePlatform(node, p)
+}
+
+// Adds the platform component with the given name, type and parent under the given root configuration
+func addComponent(node *configtree.Node, name string, componentType string, parent string) {
+	componentNode := node.AddPath(fmt.Sprintf("components/component[name=%s]", name), nil)
+	componentNode.AddPath("config/name", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	componentNode.AddPath("state/name", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	componentNode.AddPath("state/type", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: componentType}})
+	if len(parent) > 0 {
+		componentNode.AddPath("state/parent", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: parent}})
+	}
+}
+
+// UpdatePlatform applies the given platform state to the platform components under the given root configuration
+// and returns the updated leaf nodes
+func UpdatePlatform(node *configtree.Node, p *Platform) []*configtree.Node {
+	stringVal := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: s}}
+	}
+	uintVal := func(u uint64) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: u}}
+	}
+	doubleVal := func(d float64) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: d}}
+	}
+	boolVal := func(b bool) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: b}}
+	}
+	component := func(name string) string {
+		return fmt.Sprintf("components/component[name=%s]/", name)
+	}
+
+	nodes := []*configtree.Node{
+		node.AddPath(component(ChassisName)+"state/oper-status", stringVal(componentStatus(true))),
+		node.AddPath(component(ChassisName)+"state/serial-no", stringVal(p.SerialNo)),
+		node.AddPath(component(ChassisName)+"state/temperature/instant", doubleVal(p.maxTemperature())),
+		node.AddPath(component(ChassisName)+"state/memory/available", uintVal(p.MemoryAvailable)),
+		node.AddPath(component(ChassisName)+"state/memory/utilized", uintVal(p.MemoryUtilized)),
+		node.AddPath(component(LinecardName)+"state/oper-status", stringVal(componentStatus(true))),
+		node.AddPath(component(CPUName)+"state/oper-status", stringVal(componentStatus(true))),
+		node.AddPath(component(CPUName)+"cpu/utilization/state/instant", uintVal(uint64(p.CPUUtilization))),
+	}
+	for _, fan := range p.Fans {
+		nodes = append(nodes,
+			node.AddPath(component(fan.Name)+"state/oper-status", stringVal(componentStatus(!fan.Failed))),
+			node.AddPath(component(fan.Name)+"fan/state/speed", uintVal(fan.Speed)))
+	}
+	for _, psu := range p.PSUs {
+		nodes = append(nodes,
+			node.AddPath(component(psu.Name)+"state/oper-status", stringVal(componentStatus(!psu.Failed))),
+			node.AddPath(component(psu.Name)+"power-supply/state/enabled", boolVal(!psu.Failed)),
+			node.AddPath(component(psu.Name)+"power-supply/state/capacity", doubleVal(psu.Capacity)),
+			node.AddPath(component(psu.Name)+"power-supply/state/output-power", doubleVal(psu.OutputPower)))
+	}
+	for _, sensor := range p.Sensors {
+		nodes = append(nodes,
+			node.AddPath(component(sensor.Name)+"state/oper-status", stringVal(componentStatus(true))),
+			node.AddPath(component(sensor.Name)+"state/temperature/instant", doubleVal(sensor.Temperature)),
+			node.AddPath(component(sensor.Name)+"state/temperature/alarm-threshold", uintVal(uint64(sensor.AlarmThreshold))),
+			node.AddPath(component(sensor.Name)+"state/temperature/alarm-status", boolVal(sensor.Alarm())))
+	}
+	return nodes
+}
+
+// Returns the highest temperature of all sensors
+func (p *Platform) maxTemperature() float64 {
+	max := 0.0
+	for _, sensor := range p.Sensors {
+		if sensor.Temperature > max {
+			max = sensor.Temperature
+		}
+	}
+	return max
+}
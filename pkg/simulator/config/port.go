@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-net-lib/pkg/configtree"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// PortComponentName returns the name of the platform component representing the named physical port
+func PortComponentName(portName string) string {
+	return "port-" + portName
+}
+
+// AddPortComponent adds the platform component of the named physical port, with its breakout mode set to
+// the given number of child ports and their speed, under the given root configuration
+func AddPortComponent(node *configtree.Node, portName string, numBreakouts int, breakoutSpeed string) {
+	name := PortComponentName(portName)
+	componentNode := node.AddPath(fmt.Sprintf("components/component[name=%s]", name), nil)
+	componentNode.AddPath("state/name", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	componentNode.AddPath("state/type", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "PORT"}})
+	SetPortBreakout(node, portName, numBreakouts, breakoutSpeed)
+}
+
+// SetPortBreakout sets the breakout mode of the named physical port under the given root configuration
+func SetPortBreakout(node *configtree.Node, portName string, numBreakouts int, breakoutSpeed string) {
+	groupPath := fmt.Sprintf("components/component[name=%s]/port/breakout-mode/groups/group[index=0]",
+		PortComponentName(portName))
+	for _, mode := range []string{"config", "state"} {
+		node.AddPath(fmt.Sprintf("%s/%s/index", groupPath, mode),
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 0}})
+		node.AddPath(fmt.Sprintf("%s/%s/num-breakouts", groupPath, mode),
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(numBreakouts)}})
+		node.AddPath(fmt.Sprintf("%s/%s/breakout-speed", groupPath, mode),
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: breakoutSpeed}})
+	}
+}
+
+// GetPortBreakoutConfig returns the configured breakout mode of the named physical port under the given root
+// configuration; zero number of breakouts if not configured
+func GetPortBreakoutConfig(node *configtree.Node, portName string) (int, string) {
+	groupPath := fmt.Sprintf("components/component[name=%s]/port/breakout-mode/groups/group[index=0]/config",
+		PortComponentName(portName))
+	numBreakouts := 0
+	if n := node.GetPath(groupPath + "/num-breakouts"); n != nil {
+		numBreakouts = int(n.Value().GetUintVal())
+	}
+	breakoutSpeed := ""
+	if n := node.GetPath(groupPath + "/breakout-speed"); n != nil {
+		breakoutSpeed = n.Value().GetStringVal()
+	}
+	return numBreakouts, breakoutSpeed
+}
+
+// GetPortSpeedConfig returns the configured speed and auto-negotiation of the named port under the given root
+// configuration
+func GetPortSpeedConfig(node *configtree.Node, portName string) (string, bool) {
+	ethernetPath := fmt.Sprintf("interfaces/interface[name=%s]/ethernet/config", portName)
+	speed := ""
+	if n := node.GetPath(ethernetPath + "/port-speed"); n != nil {
+		speed = n.Value().GetStringVal()
+	}
+	autoNegotiate := false
+	if n := node.GetPath(ethernetPath + "/auto-negotiate"); n != nil {
+		autoNegotiate = n.Value().GetBoolVal()
+	}
+	return speed, autoNegotiate
+}
+
+// SetPortSpeedConfig sets the configured speed and auto-negotiation of the named port under the given root
+// configuration
+func SetPortSpeedConfig(node *configtree.Node, portName string, speed string, autoNegotiate bool) {
+	ethernetPath := fmt.Sprintf("interfaces/interface[name=%s]/ethernet/config", portName)
+	node.AddPath(ethernetPath+"/port-speed", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: speed}})
+	node.AddPath(ethernetPath+"/auto-negotiate", &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: autoNegotiate}})
+}
+
+// SetNegotiatedPortSpeed sets the speed negotiated by the named port under the given root configuration; empty
+// speed removes it
+func SetNegotiatedPortSpeed(node *configtree.Node, portName string, speed string) {
+	path := fmt.Sprintf("interfaces/interface[name=%s]/ethernet/state/negotiated-port-speed", portName)
+	if len(speed) == 0 {
+		node.DeletePath(path)
+		return
+	}
+	node.AddPath(path, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: speed}})
+}
+
+// RemovePortInterface removes the interface of the named port from under the given root configuration
+func RemovePortInterface(node *configtree.Node, portName string) {
+	node.DeletePath(fmt.Sprintf("interfaces/interface[name=%s]", portName))
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-net-lib/pkg/configtree"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/protobuf/proto"
+	"math/rand"
+	"strings"
+)
+
+// DefaultSchedulerPolicy is the name of the scheduler policy applied to the output queues of all ports
+const DefaultSchedulerPolicy = "default"
+
+const (
+	// Capacity of each queue and the number of packets drained from a queue per unit of depth, both in packets
+	queueCapacity  = 1024
+	queueDrainRate = 100
+	// One in this many packets is dropped while a queue is congested
+	queueDropRate = 100
+)
+
+// Share in percent of the egress traffic of a port carried by each of its queues, which are named by their index;
+// the last queue is served with strict priority, while the rest are served by weighted round-robin
+var queueShares = []uint64{40, 20, 10, 10, 5, 5, 5, 5}
+
+// Returns the name of the queue with the given index
+func queueName(i int) string {
+	return fmt.Sprintf("%d", i)
+}
+
+// Adds the queues and the default scheduler policy under the given root configuration
+func addQoS(node *configtree.Node) {
+	stringVal := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: s}}
+	}
+	uintVal := func(u uint64) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: u}}
+	}
+
+	for i := range queueShares {
+		node.AddPath(fmt.Sprintf("qos/queues/queue[name=%s]/config/name", queueName(i)), stringVal(queueName(i)))
+		node.AddPath(fmt.Sprintf("qos/queues/queue[name=%s]/state/name", queueName(i)), stringVal(queueName(i)))
+	}
+
+	policyNode := node.AddPath(fmt.Sprintf("qos/scheduler-policies/scheduler-policy[name=%s]", DefaultSchedulerPolicy), nil)
+	policyNode.AddPath("config/name", stringVal(DefaultSchedulerPolicy))
+	strictNode := policyNode.AddPath("schedulers/scheduler[sequence=0]", nil)
+	strictNode.AddPath("config/sequence", uintVal(0))
+	strictNode.AddPath("config/priority", stringVal("STRICT"))
+	wrrNode := policyNode.AddPath("schedulers/scheduler[sequence=1]", nil)
+	wrrNode.AddPath("config/sequence", uintVal(1))
+	for i, share := range queueShares {
+		schedulerNode, weight := wrrNode, share
+		if i == len(queueShares)-1 {
+			schedulerNode, weight = strictNode, 0
+		}
+		inputNode := schedulerNode.AddPath(fmt.Sprintf("inputs/input[id=%s]", queueName(i)), nil)
+		inputNode.AddPath("config/id", stringVal(queueName(i)))
+		inputNode.AddPath("config/input-type", stringVal("QUEUE"))
+		inputNode.AddPath("config/queue", stringVal(queueName(i)))
+		inputNode.AddPath("config/weight", uintVal(weight))
+	}
+	ApplyQoS(node)
+}
+
+// Adds the QoS interface of the named port, with its output queues and the default scheduler policy, under the
+// given root configuration
+func addQoSInterface(node *configtree.Node, name string) {
+	interfaceNode := node.AddPath(fmt.Sprintf("qos/interfaces/interface[interface-id=%s]", name), nil)
+	interfaceNode.AddPath("config/interface-id", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	interfaceNode.AddPath("state/interface-id", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	interfaceNode.AddPath("output/scheduler-policy/config/name",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: DefaultSchedulerPolicy}})
+	interfaceNode.AddPath("output/scheduler-policy/state/name",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: DefaultSchedulerPolicy}})
+	for i := range queueShares {
+		queueNode := interfaceNode.AddPath(fmt.Sprintf("output/queues/queue[name=%s]", queueName(i)), nil)
+		queueNode.AddPath("state/name", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: queueName(i)}})
+		for _, stat := range queueStats {
+			queueNode.AddPath("state/"+stat, &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 0}})
+		}
+	}
+}
+
+var queueStats = []string{
+	"transmit-pkts",
+	"transmit-octets",
+	"dropped-pkts",
+	"avg-queue-len",
+	"max-queue-len",
+}
+
+// Returns the output queues node of the named port under the given root configuration; nil if none
+func getQueuesNode(node *configtree.Node, name string) *configtree.Node {
+	return node.GetPath(fmt.Sprintf("qos/interfaces/interface[interface-id=%s]/output/queues", name))
+}
+
+// Spreads the given amounts of egress packets and bytes across the given output queues and returns the updated
+// queue statistics nodes; congested queues drop some of their packets
+func addQueueTraffic(queuesNode *configtree.Node, packets uint64, bytes uint64) []*configtree.Node {
+	if queuesNode == nil || packets == 0 {
+		return nil
+	}
+	nodes := make([]*configtree.Node, 0, len(queueShares)*len(queueStats))
+	for i, share := range queueShares {
+		queueNode := queuesNode.Get("queue", map[string]string{"name": queueName(i)})
+		if queueNode == nil {
+			continue
+		}
+		queuePackets := packets * share / 100
+		queueBytes := bytes * share / 100
+		depth := queuePackets / queueDrainRate
+		if depth > 0 {
+			depth = depth/2 + uint64(rand.Int63n(int64(depth)))
+		}
+		dropped := uint64(0)
+		if depth >= queueCapacity {
+			depth = queueCapacity
+			dropped = queuePackets / queueDropRate
+		}
+
+		stat := func(name string) *configtree.Node {
+			return queueNode.GetPath("state/" + name)
+		}
+		addToCounter(stat("transmit-pkts"), queuePackets-dropped)
+		droppedBytes := uint64(0)
+		if dropped > 0 {
+			droppedBytes = queueBytes * dropped / queuePackets
+		}
+		addToCounter(stat("transmit-octets"), queueBytes-droppedBytes)
+		addToCounter(stat("dropped-pkts"), dropped)
+		stat("avg-queue-len").Value().Value = &gnmi.TypedValue_UintVal{UintVal: depth}
+		if depth > stat("max-queue-len").Value().GetUintVal() {
+			stat("max-queue-len").Value().Value = &gnmi.TypedValue_UintVal{UintVal: depth}
+		}
+		for _, name := range queueStats {
+			nodes = append(nodes, stat(name))
+		}
+	}
+	return nodes
+}
+
+// Resets the statistics of all output queues under the given root configuration
+func resetQueueStats(node *configtree.Node) {
+	for _, n := range node.FindAll("qos/interfaces/interface[interface-id=...]/output/queues") {
+		if n.Name() != "name" {
+			n.Value().Value = &gnmi.TypedValue_UintVal{UintVal: 0}
+		}
+	}
+}
+
+// IsSchedulerPolicyPath returns true if the given path is under the scheduler policies, whose entries, along with
+// their schedulers and inputs, can be created
+func IsSchedulerPolicyPath(elems []*gnmi.PathElem) bool {
+	return len(elems) > 1 && elems[0].Name == "qos" && elems[1].Name == "scheduler-policies"
+}
+
+// ApplyQoS reflects the configured scheduler policies and the scheduler policies of the ports under the given root
+// configuration in their state, and returns the updated state leaf nodes
+func ApplyQoS(node *configtree.Node) []*configtree.Node {
+	nodes := make([]*configtree.Node, 0)
+	configPaths := make(map[string]bool)
+	for _, n := range node.FindAll("qos") {
+		path := n.Path()
+		i := strings.LastIndex(path, "/config/")
+		if i < 0 || !isSchedulerPolicyLeaf(path) {
+			continue
+		}
+		statePath := path[:i] + "/state/" + path[i+len("/config/"):]
+		configPaths[statePath] = true
+		if stateNode := node.GetPath(statePath); stateNode == nil || !proto.Equal(stateNode.Value(), n.Value()) {
+			nodes = append(nodes, node.AddPath(statePath, proto.Clone(n.Value()).(*gnmi.TypedValue)))
+		}
+	}
+
+	// Remove the state of any scheduler policy configuration which is no longer present
+	for _, n := range node.FindAll("qos") {
+		path := n.Path()
+		if strings.Contains(path, "/state/") && isSchedulerPolicyLeaf(path) && !configPaths[path] {
+			node.DeletePath(path)
+		}
+	}
+	return nodes
+}
+
+// Returns true if the leaf at the given path belongs to a scheduler policy or to the scheduler policy of a port
+func isSchedulerPolicyLeaf(path string) bool {
+	return strings.HasPrefix(path, "qos/scheduler-policies/") || strings.Contains(path, "/output/scheduler-policy/")
+}
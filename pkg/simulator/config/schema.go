@@ -0,0 +1,389 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"strconv"
+	"strings"
+)
+
+// Type of the value of a schema leaf
+type leafType int
+
+const (
+	stringLeaf leafType = iota
+	boolLeaf
+	uintLeaf
+	doubleLeaf
+	stringListLeaf
+)
+
+func (t leafType) String() string {
+	return [...]string{"string", "bool", "uint", "double", "string list"}[t]
+}
+
+// Schema leaves of the supported OpenConfig models, keyed by their schema path; list elements carry the names
+// of their keys in brackets. This is a hand-maintained approximation of the models listed in Models, covering only
+// the leaves the simulator populates or accepts, rather than a schema generated from the YANG modules themselves.
+var schemaLeaves = map[string]leafType{
+	"interfaces/interface[name]/config/name":                          stringLeaf,
+	"interfaces/interface[name]/config/type":                          stringLeaf,
+	"interfaces/interface[name]/config/description":                   stringLeaf,
+	"interfaces/interface[name]/config/enabled":                       boolLeaf,
+	"interfaces/interface[name]/config/mtu":                           uintLeaf,
+	"interfaces/interface[name]/state/name":                           stringLeaf,
+	"interfaces/interface[name]/state/type":                           stringLeaf,
+	"interfaces/interface[name]/state/description":                    stringLeaf,
+	"interfaces/interface[name]/state/enabled":                        boolLeaf,
+	"interfaces/interface[name]/state/mtu":                            uintLeaf,
+	"interfaces/interface[name]/state/ifindex":                        uintLeaf,
+	"interfaces/interface[name]/state/id":                             uintLeaf,
+	"interfaces/interface[name]/state/admin-status":                   stringLeaf,
+	"interfaces/interface[name]/state/oper-status":                    stringLeaf,
+	"interfaces/interface[name]/state/last-change":                    uintLeaf,
+	"interfaces/interface[name]/state/transceiver":                    stringLeaf,
+	"interfaces/interface[name]/ethernet/config/port-speed":           stringLeaf,
+	"interfaces/interface[name]/ethernet/config/auto-negotiate":       boolLeaf,
+	"interfaces/interface[name]/ethernet/config/aggregate-id":         stringLeaf,
+	"interfaces/interface[name]/ethernet/state/port-speed":            stringLeaf,
+	"interfaces/interface[name]/ethernet/state/auto-negotiate":        boolLeaf,
+	"interfaces/interface[name]/ethernet/state/negotiated-port-speed": stringLeaf,
+	"interfaces/interface[name]/ethernet/state/aggregate-id":          stringLeaf,
+	"interfaces/interface[name]/aggregation/config/lag-type":          stringLeaf,
+	"interfaces/interface[name]/aggregation/config/min-links":         uintLeaf,
+	"interfaces/interface[name]/aggregation/state/lag-type":           stringLeaf,
+	"interfaces/interface[name]/aggregation/state/min-links":          uintLeaf,
+	"interfaces/interface[name]/aggregation/state/member":             stringListLeaf,
+
+	"components/component[name]/config/name":                                                                   stringLeaf,
+	"components/component[name]/state/name":                                                                    stringLeaf,
+	"components/component[name]/state/type":                                                                    stringLeaf,
+	"components/component[name]/state/parent":                                                                  stringLeaf,
+	"components/component[name]/state/oper-status":                                                             stringLeaf,
+	"components/component[name]/state/mfg-name":                                                                stringLeaf,
+	"components/component[name]/state/serial-no":                                                               stringLeaf,
+	"components/component[name]/state/part-no":                                                                 stringLeaf,
+	"components/component[name]/state/description":                                                             stringLeaf,
+	"components/component[name]/state/software-version":                                                        stringLeaf,
+	"components/component[name]/state/temperature/instant":                                                     doubleLeaf,
+	"components/component[name]/state/temperature/alarm-threshold":                                             uintLeaf,
+	"components/component[name]/state/temperature/alarm-status":                                                boolLeaf,
+	"components/component[name]/state/memory/available":                                                        uintLeaf,
+	"components/component[name]/state/memory/utilized":                                                         uintLeaf,
+	"components/component[name]/port/breakout-mode/groups/group[index]/config/index":                           uintLeaf,
+	"components/component[name]/port/breakout-mode/groups/group[index]/config/num-breakouts":                   uintLeaf,
+	"components/component[name]/port/breakout-mode/groups/group[index]/config/breakout-speed":                  stringLeaf,
+	"components/component[name]/port/breakout-mode/groups/group[index]/state/index":                            uintLeaf,
+	"components/component[name]/port/breakout-mode/groups/group[index]/state/num-breakouts":                    uintLeaf,
+	"components/component[name]/port/breakout-mode/groups/group[index]/state/breakout-speed":                   stringLeaf,
+	"components/component[name]/transceiver/state/present":                                                     stringLeaf,
+	"components/component[name]/transceiver/state/form-factor":                                                 stringLeaf,
+	"components/component[name]/transceiver/state/vendor":                                                      stringLeaf,
+	"components/component[name]/transceiver/state/vendor-part":                                                 stringLeaf,
+	"components/component[name]/transceiver/state/serial-no":                                                   stringLeaf,
+	"components/component[name]/transceiver/physical-channels/channel[index]/state/output-power/instant":       doubleLeaf,
+	"components/component[name]/transceiver/physical-channels/channel[index]/state/input-power/instant":        doubleLeaf,
+	"components/component[name]/transceiver/physical-channels/channel[index]/state/laser-bias-current/instant": doubleLeaf,
+	"components/component[name]/fan/state/speed":                                                               uintLeaf,
+	"components/component[name]/power-supply/state/enabled":                                                    boolLeaf,
+	"components/component[name]/power-supply/state/capacity":                                                   doubleLeaf,
+	"components/component[name]/power-supply/state/output-power":                                               doubleLeaf,
+	"components/component[name]/cpu/utilization/state/instant":                                                 uintLeaf,
+
+	"qos/queues/queue[name]/config/name":                                                                              stringLeaf,
+	"qos/queues/queue[name]/state/name":                                                                               stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/config/name":                                                       stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/state/name":                                                        stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/config/sequence":                    uintLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/config/priority":                    stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/state/sequence":                     uintLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/state/priority":                     stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/config/id":         stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/config/input-type": stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/config/queue":      stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/config/weight":     uintLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/state/id":          stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/state/input-type":  stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/state/queue":       stringLeaf,
+	"qos/scheduler-policies/scheduler-policy[name]/schedulers/scheduler[sequence]/inputs/input[id]/state/weight":      uintLeaf,
+	"qos/interfaces/interface[interface-id]/config/interface-id":                                                      stringLeaf,
+	"qos/interfaces/interface[interface-id]/state/interface-id":                                                       stringLeaf,
+	"qos/interfaces/interface[interface-id]/output/scheduler-policy/config/name":                                      stringLeaf,
+	"qos/interfaces/interface[interface-id]/output/scheduler-policy/state/name":                                       stringLeaf,
+	"qos/interfaces/interface[interface-id]/output/queues/queue[name]/state/name":                                     stringLeaf,
+
+	"system/config/hostname":        stringLeaf,
+	"system/state/hostname":         stringLeaf,
+	"system/state/boot-time":        uintLeaf,
+	"system/state/current-datetime": stringLeaf,
+	"system/state/software-version": stringLeaf,
+}
+
+// Node of the schema tree
+type schemaNode struct {
+	children map[string]*schemaNode
+	keys     []string
+	leaf     *leafType
+}
+
+var schemaRoot = buildSchema()
+
+// Builds the schema tree from the schema leaves, the port counters and the queue statistics
+func buildSchema() *schemaNode {
+	root := &schemaNode{children: make(map[string]*schemaNode)}
+	for path, t := range schemaLeaves {
+		root.addLeaf(path, t)
+	}
+	for _, counter := range supportedCounters {
+		root.addLeaf("interfaces/interface[name]/state/counters/"+counter, uintLeaf)
+	}
+	for _, stat := range queueStats {
+		root.addLeaf("qos/interfaces/interface[interface-id]/output/queues/queue[name]/state/"+stat, uintLeaf)
+	}
+	return root
+}
+
+// Adds the leaf with the given schema path and type, along with any missing containers and lists leading to it
+func (n *schemaNode) addLeaf(path string, t leafType) {
+	node := n
+	for _, segment := range strings.Split(path, "/") {
+		name, keys := segment, []string(nil)
+		if i := strings.Index(segment, "["); i > 0 {
+			name, keys = segment[:i], strings.Split(strings.TrimSuffix(segment[i+1:], "]"), ",")
+		}
+		child, ok := node.children[name]
+		if !ok {
+			child = &schemaNode{children: make(map[string]*schemaNode), keys: keys}
+			node.children[name] = child
+		}
+		node = child
+	}
+	node.leaf = &t
+}
+
+// Returns true if the given element name is a wildcard
+func isWildcard(name string) bool {
+	return name == "*" || name == "..."
+}
+
+// Finds the schema node at the given path; list elements must carry all their keys if requested; returns nil node
+// without an error if the path descends through a wildcard, in which case the elements and keys following the
+// wildcard must still match some of the nodes the wildcard may stand for
+func findSchemaNode(path *gnmi.Path, requireKeys bool) (*schemaNode, error) {
+	nodes := []*schemaNode{schemaRoot}
+	wildcard := false
+	for _, elem := range path.GetElem() {
+		var candidates []*schemaNode
+		switch elem.Name {
+		case "*":
+			for _, node := range nodes {
+				candidates = append(candidates, node.childNodes()...)
+			}
+		case "...":
+			for _, node := range nodes {
+				candidates = append(candidates, node.descendants()...)
+			}
+		default:
+			for _, node := range nodes {
+				if child, ok := node.children[elem.Name]; ok {
+					candidates = append(candidates, child)
+				}
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, errors.NewInvalid("unknown element %s in path %s", elem.Name, gnmiutils.ToString(path))
+		}
+		for key := range elem.Key {
+			if !anyHasKey(candidates, key) {
+				return nil, errors.NewInvalid("unknown key %s of element %s in path %s", key, elem.Name, gnmiutils.ToString(path))
+			}
+		}
+		if requireKeys && !isWildcard(elem.Name) && !anyHasKeys(candidates, len(elem.Key)) {
+			return nil, errors.NewInvalid("missing keys %v of element %s in path %s", candidates[0].keys, elem.Name, gnmiutils.ToString(path))
+		}
+		wildcard = wildcard || isWildcard(elem.Name)
+		nodes = unique(candidates)
+	}
+	if wildcard {
+		return nil, nil
+	}
+	return nodes[0], nil
+}
+
+// Returns the immediate children of the node
+func (n *schemaNode) childNodes() []*schemaNode {
+	nodes := make([]*schemaNode, 0, len(n.children))
+	for _, child := range n.children {
+		nodes = append(nodes, child)
+	}
+	return nodes
+}
+
+// Returns the node along with all its descendants
+func (n *schemaNode) descendants() []*schemaNode {
+	nodes := []*schemaNode{n}
+	for _, child := range n.children {
+		nodes = append(nodes, child.descendants()...)
+	}
+	return nodes
+}
+
+// Returns true if any of the given nodes is a list with the given key
+func anyHasKey(nodes []*schemaNode, key string) bool {
+	for _, node := range nodes {
+		if contains(node.keys, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if any of the given nodes has no more than the given number of keys
+func anyHasKeys(nodes []*schemaNode, count int) bool {
+	for _, node := range nodes {
+		if len(node.keys) <= count {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the given nodes without duplicates, preserving their order
+func unique(nodes []*schemaNode) []*schemaNode {
+	seen := make(map[*schemaNode]bool, len(nodes))
+	result := nodes[:0]
+	for _, node := range nodes {
+		if !seen[node] {
+			seen[node] = true
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// IsConfigLeaf returns true if the given path elements lead to a configuration leaf of the supported models
+func IsConfigLeaf(elems []*gnmi.PathElem) bool {
+	node, err := findSchemaNode(&gnmi.Path{Elem: elems}, true)
+	if err != nil || node == nil || node.leaf == nil {
+		return false
+	}
+	for _, elem := range elems {
+		if elem.Name == "config" {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateGetPath returns an error if the given path does not lead to any node of the supported models
+func ValidateGetPath(prefix *gnmi.Path, path *gnmi.Path) error {
+	_, err := findSchemaNode(JoinPaths(prefix, path), false)
+	return err
+}
+
+// ValidateDeletePath returns an error if the given path does not lead to any node of the supported models
+func ValidateDeletePath(prefix *gnmi.Path, path *gnmi.Path) error {
+	_, err := findSchemaNode(JoinPaths(prefix, path), true)
+	return err
+}
+
+// ValidateUpdate returns an error if the given path does not lead to any node of the supported models or if the
+// given value does not match the type of the leaf at that path
+func ValidateUpdate(prefix *gnmi.Path, path *gnmi.Path, val *gnmi.TypedValue) error {
+	full := JoinPaths(prefix, path)
+	node, err := findSchemaNode(full, true)
+	if err != nil || node == nil {
+		return err
+	}
+	if node.leaf == nil {
+		// Containers can be set only using JSON values
+		if val.GetJsonVal() == nil && val.GetJsonIetfVal() == nil {
+			return errors.NewInvalid("path %s is not a leaf", gnmiutils.ToString(full))
+		}
+		return nil
+	}
+	if !hasLeafType(val, *node.leaf) {
+		return errors.NewInvalid("value of %s must be %s", gnmiutils.ToString(full), *node.leaf)
+	}
+	return nil
+}
+
+// Returns true if the given value is of the given leaf type
+func hasLeafType(val *gnmi.TypedValue, t leafType) bool {
+	switch v := val.GetValue().(type) {
+	case *gnmi.TypedValue_StringVal, *gnmi.TypedValue_AsciiVal:
+		return t == stringLeaf
+	case *gnmi.TypedValue_BoolVal:
+		return t == boolLeaf
+	case *gnmi.TypedValue_UintVal:
+		return t == uintLeaf
+	case *gnmi.TypedValue_IntVal:
+		return t == uintLeaf && v.IntVal >= 0
+	case *gnmi.TypedValue_DoubleVal, *gnmi.TypedValue_FloatVal:
+		return t == doubleLeaf
+	case *gnmi.TypedValue_LeaflistVal:
+		if t != stringListLeaf {
+			return false
+		}
+		for _, element := range v.LeaflistVal.GetElement() {
+			if !hasLeafType(element, stringLeaf) {
+				return false
+			}
+		}
+		return true
+	case *gnmi.TypedValue_JsonVal:
+		return hasJSONLeafType(v.JsonVal, t)
+	case *gnmi.TypedValue_JsonIetfVal:
+		return hasJSONLeafType(v.JsonIetfVal, t)
+	}
+	return false
+}
+
+// Returns true if the given JSON value is of the given leaf type; 64-bit integers may be represented as strings
+func hasJSONLeafType(b []byte, t leafType) bool {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return false
+	}
+	switch jv := v.(type) {
+	case string:
+		if t == uintLeaf {
+			_, err := strconv.ParseUint(jv, 10, 64)
+			return err == nil
+		}
+		return t == stringLeaf
+	case bool:
+		return t == boolLeaf
+	case float64:
+		return t == doubleLeaf || (t == uintLeaf && jv >= 0 && jv == float64(uint64(jv)))
+	case []interface{}:
+		for _, element := range jv {
+			if _, ok := element.(string); !ok || t != stringListLeaf {
+				return false
+			}
+		}
+		return t == stringListLeaf
+	}
+	return false
+}
+
+// JoinPaths returns the given path prefixed by the given prefix
+func JoinPaths(prefix *gnmi.Path, path *gnmi.Path) *gnmi.Path {
+	elems := make([]*gnmi.PathElem, 0, len(prefix.GetElem())+len(path.GetElem()))
+	return &gnmi.Path{Elem: append(append(elems, prefix.GetElem()...), path.GetElem()...)}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
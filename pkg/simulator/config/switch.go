@@ -13,40 +13,47 @@ import (
 	"github.com/onosproject/onos-net-lib/pkg/gnmiutils"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"math/rand"
+	"sync"
 	"time"
 )
 
 // NewSwitchConfig creates a new switch skeleton configuration and returns its root node
 func NewSwitchConfig(ports map[simapi.PortID]*simapi.Port) *configtree.Node {
 	rootNode := configtree.NewRoot()
-
-	interfacesNode := rootNode.Add("interfaces", nil, nil)
-	index := uint64(1)
+	rootNode.Add("interfaces", nil, nil)
+	addQoS(rootNode)
 	for _, port := range ports {
-		name := port.Name
-		if len(name) == 0 {
-			name = fmt.Sprintf("%d", port.Number)
-		}
-		interfaceNode := interfacesNode.Add("interface", map[string]string{"name": name}, nil)
-
-		interfaceNode.AddPath("state/ifindex",
-			&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(port.Number)}})
-		interfaceNode.AddPath("state/id",
-			&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(port.InternalNumber)}})
+		AddPortInterface(rootNode, port)
+	}
+	return rootNode
+}
 
-		SetPortStatus(interfaceNode, GetStatusString(port.Enabled))
+// AddPortInterface adds the interface of the given port, along with its QoS interface, under the given root
+// configuration and returns the interface node
+func AddPortInterface(node *configtree.Node, port *simapi.Port) *configtree.Node {
+	name := port.Name
+	if len(name) == 0 {
+		name = fmt.Sprintf("%d", port.Number)
+	}
+	interfaceNode := node.AddPath(fmt.Sprintf("interfaces/interface[name=%s]", name), nil)
 
-		interfaceNode.AddPath("config/enabled",
-			&gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: port.Enabled}})
-		interfaceNode.AddPath("ethernet/config/port-speed",
-			&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: port.Speed}})
+	interfaceNode.AddPath("state/ifindex",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(port.Number)}})
+	interfaceNode.AddPath("state/id",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(port.InternalNumber)}})
 
-		index = index + 1
+	SetPortStatus(interfaceNode, GetStatusString(port.Enabled))
 
-		addCounters(interfaceNode)
-	}
+	interfaceNode.AddPath("config/enabled",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: port.Enabled}})
+	interfaceNode.AddPath("ethernet/config/port-speed",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: port.Speed}})
+	interfaceNode.AddPath("ethernet/config/auto-negotiate",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: false}})
 
-	return rootNode
+	addCounters(interfaceNode)
+	addQoSInterface(node, name)
+	return interfaceNode
 }
 
 // GetStatusString returns port status string based on the enabled flag: UP or DOWN
@@ -85,7 +92,7 @@ var supportedCounters = []string{
 func addCounters(node *configtree.Node) {
 	countersNode := node.AddPath("state/counters", nil)
 	for _, counter := range supportedCounters {
-		countersNode.Add(counter, nil, &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: 0}})
+		countersNode.Add(counter, nil, &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 0}})
 	}
 }
 
@@ -96,9 +103,11 @@ type portData struct {
 	bytesOut   *configtree.Node
 	packetsIn  *configtree.Node
 	packetsOut *configtree.Node
+	queues     *configtree.Node
 }
 
-func (d *portData) simulateTrafficCounter() {
+// Simulates the traffic counters of the port and returns the updated output queue statistics nodes
+func (d *portData) simulateTrafficCounter() []*configtree.Node {
 	delta := time.Since(d.lastUpdate).Milliseconds()
 
 	// Simulate increases in packets-in and bytes-in in some reasonable proportion to each other
@@ -109,9 +118,11 @@ func (d *portData) simulateTrafficCounter() {
 
 	// Simulate increases in packets-out and bytes-out in some reasonable proportion to each other
 	pout := packetsAmount(delta, d.packetsOut.Value().GetUintVal())
-	d.packetsOut.Value().Value = &gnmi.TypedValue_UintVal{UintVal: pout}
 	bout := bytesAmount(delta, d.bytesOut.Value().GetUintVal(), pout)
+	queueNodes := addQueueTraffic(d.queues, pout-d.packetsOut.Value().GetUintVal(), bout-d.bytesOut.Value().GetUintVal())
 	d.bytesOut.Value().Value = &gnmi.TypedValue_UintVal{UintVal: bout}
+	d.packetsOut.Value().Value = &gnmi.TypedValue_UintVal{UintVal: pout}
+	return queueNodes
 }
 
 const (
@@ -135,25 +146,88 @@ func bytesAmount(millis int64, value uint64, packets uint64) uint64 {
 }
 
 // SimulateTrafficCounters simulates a select set of traffic-related counters for all ports under the given
-// root configuration
-func SimulateTrafficCounters(ctx context.Context, delay time.Duration, node *configtree.Node) {
+// root configuration, which is guarded by the given lock; the simulation is skipped while the given suspended
+// function returns true, and the updated counter nodes are passed, with the lock held, to the given updated function
+// after each round. Ports added or removed since the previous round are picked up as well.
+func SimulateTrafficCounters(ctx context.Context, delay time.Duration, node *configtree.Node, lock sync.Locker,
+	suspended func() bool, updated func(nodes []*configtree.Node)) {
+	lock.Lock()
 	portCounters := findCountersToSimulate(node)
+	lock.Unlock()
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-time.After(delay):
-				simulateTrafficCounters(portCounters)
+				if !suspended() {
+					lock.Lock()
+					portCounters = refreshCountersToSimulate(node, portCounters)
+					updated(simulateTrafficCounters(portCounters))
+					lock.Unlock()
+				}
 			}
 		}
 	}()
 }
 
-func simulateTrafficCounters(counters map[string]*portData) {
+// AddPortTraffic adds the given amounts of bytes and packets to the in and out counters of the named port
+// under the given root configuration, spreads the out traffic across its output queues and returns the updated
+// counter and queue statistics nodes
+func AddPortTraffic(node *configtree.Node, portName string, inBytes uint64, inPackets uint64, outBytes uint64, outPackets uint64) []*configtree.Node {
+	countersNode := node.GetPath(fmt.Sprintf("interfaces/interface[name=%s]/state/counters", portName))
+	if countersNode == nil {
+		return nil
+	}
+	nodes := addQueueTraffic(getQueuesNode(node, portName), outPackets, outBytes)
+	for name, amount := range map[string]uint64{"in-octets": inBytes, "in-unicast-pkts": inPackets,
+		"out-octets": outBytes, "out-unicast-pkts": outPackets} {
+		if counterNode := countersNode.GetPath(name); counterNode != nil && amount > 0 {
+			addToCounter(counterNode, amount)
+			nodes = append(nodes, counterNode)
+		}
+	}
+	return nodes
+}
+
+// AddPortCounter adds the given amount to the named counter of the named port under the given root configuration
+func AddPortCounter(node *configtree.Node, portName string, counter string, amount uint64) {
+	addToCounter(node.GetPath(fmt.Sprintf("interfaces/interface[name=%s]/state/counters/%s", portName, counter)), amount)
+}
+
+// ResetPortTraffic resets the in and out traffic counters and the output queue statistics of all ports under the
+// given root configuration
+func ResetPortTraffic(node *configtree.Node) {
+	for _, n := range node.FindAll("interfaces/interface[name=...]/state/counters") {
+		if isSimulated(n.Name()) {
+			n.Value().Value = &gnmi.TypedValue_UintVal{UintVal: 0}
+		}
+	}
+	resetQueueStats(node)
+}
+
+// ResetPortCounters resets all counters and the output queue statistics of all ports under the given root
+// configuration
+func ResetPortCounters(node *configtree.Node) {
+	for _, n := range node.FindAll("interfaces/interface[name=...]/state/counters") {
+		n.Value().Value = &gnmi.TypedValue_UintVal{UintVal: 0}
+	}
+	resetQueueStats(node)
+}
+
+func addToCounter(node *configtree.Node, amount uint64) {
+	if node != nil {
+		node.Value().Value = &gnmi.TypedValue_UintVal{UintVal: node.Value().GetUintVal() + amount}
+	}
+}
+
+func simulateTrafficCounters(counters map[string]*portData) []*configtree.Node {
+	nodes := make([]*configtree.Node, 0, 4*len(counters))
 	for _, data := range counters {
-		data.simulateTrafficCounter()
+		nodes = append(nodes, data.simulateTrafficCounter()...)
+		nodes = append(nodes, data.packetsIn, data.bytesIn, data.packetsOut, data.bytesOut)
 	}
+	return nodes
 }
 
 func findCountersToSimulate(node *configtree.Node) map[string]*portData {
@@ -174,6 +248,21 @@ func findCountersToSimulate(node *configtree.Node) map[string]*portData {
 			}
 		}
 	}
+	for portName, data := range portCounters {
+		data.queues = getQueuesNode(node, portName)
+	}
+	return portCounters
+}
+
+// Finds the counters of all ports presently under the given root configuration, carrying over the time of the last
+// update of the ports already simulated
+func refreshCountersToSimulate(node *configtree.Node, counters map[string]*portData) map[string]*portData {
+	portCounters := findCountersToSimulate(node)
+	for portName, data := range portCounters {
+		if previous, ok := counters[portName]; ok {
+			data.lastUpdate = previous.lastUpdate
+		}
+	}
 	return portCounters
 }
 
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-net-lib/pkg/configtree"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"time"
+)
+
+// DefaultSoftwareVersion is the software version the simulated devices run unless upgraded
+const DefaultSoftwareVersion = "1.0.0"
+
+// Names of the operating system components carrying the running software and the standby software, i.e. the
+// software pending activation or the software whose activation failed
+const (
+	OSName        = "os"
+	StandbyOSName = "os-standby"
+)
+
+// AddSystem adds the system container with the given hostname and software version under the given root
+// configuration
+func AddSystem(node *configtree.Node, hostname string, softwareVersion string) {
+	node.AddPath("system/config/hostname", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: hostname}})
+	node.AddPath("system/state/hostname", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: hostname}})
+	addComponent(node, OSName, "OPERATING_SYSTEM", ChassisName)
+	node.AddPath(fmt.Sprintf("components/component[name=%s]/state/oper-status", OSName),
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "ACTIVE"}})
+	SetSoftwareVersion(node, softwareVersion)
+	SetBootTime(node, time.Now())
+	UpdateCurrentDatetime(node)
+}
+
+// SetBootTime sets the boot time of the system under the given root configuration
+func SetBootTime(node *configtree.Node, bootTime time.Time) {
+	node.AddPath("system/state/boot-time",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(bootTime.UnixNano())}})
+}
+
+// SetSoftwareVersion sets the running software version of the system under the given root configuration and returns
+// the updated leaf nodes
+func SetSoftwareVersion(node *configtree.Node, softwareVersion string) []*configtree.Node {
+	return []*configtree.Node{
+		node.AddPath("system/state/software-version",
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: softwareVersion}}),
+		node.AddPath(fmt.Sprintf("components/component[name=%s]/state/software-version", OSName),
+			&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: softwareVersion}}),
+	}
+}
+
+// SetStandbySoftware sets the standby software version of the system under the given root configuration, along with
+// the reason why its activation failed, if it did; empty version removes the standby software. Returns the updated
+// leaf nodes.
+func SetStandbySoftware(node *configtree.Node, softwareVersion string, failure string) []*configtree.Node {
+	path := fmt.Sprintf("components/component[name=%s]", StandbyOSName)
+	if len(softwareVersion) == 0 {
+		node.DeletePath(path)
+		return nil
+	}
+	if node.GetPath(path) == nil {
+		addComponent(node, StandbyOSName, "OPERATING_SYSTEM", ChassisName)
+	}
+	status, description := "INACTIVE", "pending activation"
+	if len(failure) > 0 {
+		status, description = "DISABLED", "activation failed: "+failure
+	}
+	return []*configtree.Node{
+		node.AddPath(path+"/state/software-version", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: softwareVersion}}),
+		node.AddPath(path+"/state/oper-status", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: status}}),
+		node.AddPath(path+"/state/description", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: description}}),
+	}
+}
+
+// GetSoftwareVersion returns the software version of the system under the given root configuration
+func GetSoftwareVersion(node *configtree.Node) string {
+	if n := node.GetPath("system/state/software-version"); n != nil {
+		return n.Value().GetStringVal()
+	}
+	return ""
+}
+
+// UpdateCurrentDatetime sets the current date and time of the system under the given root configuration
+func UpdateCurrentDatetime(node *configtree.Node) {
+	node.AddPath("system/state/current-datetime",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: time.Now().Format(time.RFC3339)}})
+}
+
+// ApplyHostname reflects the configured hostname of the system under the given root configuration in its state;
+// returns true if the hostname changed
+func ApplyHostname(node *configtree.Node) bool {
+	configNode := node.GetPath("system/config/hostname")
+	stateNode := node.GetPath("system/state/hostname")
+	if configNode == nil || stateNode == nil || configNode.Value().GetStringVal() == stateNode.Value().GetStringVal() {
+		return false
+	}
+	node.AddPath("system/state/hostname", configNode.Value())
+	return true
+}
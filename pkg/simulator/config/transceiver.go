@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-net-lib/pkg/configtree"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Transceiver carries the inventory and digital optical monitoring (DOM) state of a port transceiver
+type Transceiver struct {
+	Present    bool
+	Vendor     string
+	VendorPart string
+	SerialNo   string
+	FormFactor string
+	// TxPower and RxPower are the output and input optical power in dBm
+	TxPower float64
+	RxPower float64
+	// Temperature is in degrees Celsius
+	Temperature float64
+	// Bias is the laser bias current in mA
+	Bias float64
+}
+
+// Maps the port speed, in bits per second, to the form factor of transceivers typical for that speed
+var formFactors = map[uint64]string{
+	400e9: "QSFP56_DD_TYPE1", 200e9: "QSFP56", 100e9: "QSFP28", 40e9: "QSFP_PLUS", 25e9: "SFP28", 10e9: "SFP_PLUS",
+}
+
+// NewTransceiver creates a present and healthy transceiver suitable for a port of the given number and speed, in
+// bits per second
+func NewTransceiver(portNumber uint32, speed uint64) *Transceiver {
+	formFactor, ok := formFactors[speed]
+	if !ok {
+		formFactor = "SFP"
+	}
+	return &Transceiver{
+		Present:     true,
+		Vendor:      "FABRIC-SIM",
+		VendorPart:  "FS-" + formFactor,
+		SerialNo:    fmt.Sprintf("FS%08d", portNumber),
+		FormFactor:  formFactor,
+		TxPower:     1.0,
+		RxPower:     -2.0,
+		Temperature: 35.0,
+		Bias:        6.5,
+	}
+}
+
+// TransceiverName returns the name of the platform component representing the transceiver of the named port
+func TransceiverName(portName string) string {
+	return "transceiver-" + portName
+}
+
+// AddTransceiver adds the transceiver component of the named port under the given root configuration and
+// references it from the port interface
+func AddTransceiver(node *configtree.Node, portName string, t *Transceiver) {
+	name := TransceiverName(portName)
+	SetInterfaceTransceiver(node, portName, portName)
+	componentNode := node.AddPath(fmt.Sprintf("components/component[name=%s]", name), nil)
+	componentNode.AddPath("state/name", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	componentNode.AddPath("state/type", &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "TRANSCEIVER"}})
+	UpdateTransceiver(node, portName, t)
+}
+
+// SetInterfaceTransceiver references the transceiver of the named physical port from the interface of the named
+// port, which is either the physical port itself or one of its breakout child ports
+func SetInterfaceTransceiver(node *configtree.Node, portName string, physicalPortName string) {
+	node.AddPath(fmt.Sprintf("interfaces/interface[name=%s]/state/transceiver", portName),
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: TransceiverName(physicalPortName)}})
+}
+
+// UpdateTransceiver applies the given transceiver state to the transceiver component of the named port under
+// the given root configuration and returns the updated leaf nodes
+func UpdateTransceiver(node *configtree.Node, portName string, t *Transceiver) []*configtree.Node {
+	componentNode := node.GetPath(fmt.Sprintf("components/component[name=%s]", TransceiverName(portName)))
+	if componentNode == nil {
+		return nil
+	}
+	present := "NOT_PRESENT"
+	if t.Present {
+		present = "PRESENT"
+	}
+	stringVal := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: s}}
+	}
+	doubleVal := func(d float64) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: d}}
+	}
+	return []*configtree.Node{
+		componentNode.AddPath("state/oper-status", stringVal(componentStatus(t.Present))),
+		componentNode.AddPath("state/mfg-name", stringVal(t.Vendor)),
+		componentNode.AddPath("state/serial-no", stringVal(t.SerialNo)),
+		componentNode.AddPath("state/temperature/instant", doubleVal(t.Temperature)),
+		componentNode.AddPath("transceiver/state/present", stringVal(present)),
+		componentNode.AddPath("transceiver/state/form-factor", stringVal(t.FormFactor)),
+		componentNode.AddPath("transceiver/state/vendor", stringVal(t.Vendor)),
+		componentNode.AddPath("transceiver/state/vendor-part", stringVal(t.VendorPart)),
+		componentNode.AddPath("transceiver/state/serial-no", stringVal(t.SerialNo)),
+		componentNode.AddPath("transceiver/physical-channels/channel[index=0]/state/output-power/instant", doubleVal(t.TxPower)),
+		componentNode.AddPath("transceiver/physical-channels/channel[index=0]/state/input-power/instant", doubleVal(t.RxPower)),
+		componentNode.AddPath("transceiver/physical-channels/channel[index=0]/state/laser-bias-current/instant", doubleVal(t.Bias)),
+	}
+}
+
+// Returns the component oper-status string based on the present flag: ACTIVE or DISABLED
+func componentStatus(present bool) string {
+	if present {
+		return "ACTIVE"
+	}
+	return "DISABLED"
+}
@@ -17,6 +17,7 @@ import (
 	"math/rand"
 	"strings"
 	"sync"
+	"time"
 )
 
 const linkDomainDelimiter = "::"
@@ -36,6 +37,17 @@ type Simulation struct {
 	usedIngressPorts map[simapi.PortID]*linkOrNIC
 
 	peers map[string]*peerSimulator
+
+	trafficFlows []*TrafficFlow
+	trafficDone  chan string
+
+	validateSchema bool
+
+	// Persisted configuration set requests of each device and the directory in which they are persisted, if any
+	deviceConfigs map[simapi.DeviceID][]*gnmi.SetRequest
+	configDir     string
+
+	rebootDowntime time.Duration
 }
 
 // NewSimulation creates a new core simulation entity
@@ -47,11 +59,28 @@ func NewSimulation() *Simulation {
 		usedEgressPorts:  make(map[simapi.PortID]*linkOrNIC),
 		usedIngressPorts: make(map[simapi.PortID]*linkOrNIC),
 		peers:            make(map[string]*peerSimulator),
+		deviceConfigs:    make(map[simapi.DeviceID][]*gnmi.SetRequest),
+		rebootDowntime:   DefaultRebootDowntime,
 	}
 	simulation.Collector = newStatsCollector(simulation)
 	return simulation
 }
 
+// SetSchemaValidation enables or disables validation of the paths and values of gNMI get and set requests against
+// the schema of the OpenConfig models supported by the simulated devices
+func (s *Simulation) SetSchemaValidation(enabled bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.validateSchema = enabled
+}
+
+// IsSchemaValidationEnabled returns true if the gNMI get and set requests are validated against the schema
+func (s *Simulation) IsSchemaValidationEnabled() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.validateSchema
+}
+
 // DeviceAgent is an abstraction of P4Runtime and gNMI NB server
 type DeviceAgent interface {
 	// Start starts the simulated device agent
@@ -97,8 +126,10 @@ type SubscribeResponder interface {
 }
 
 type linkOrNIC struct {
-	link *simapi.Link
-	nic  *simapi.NetworkInterface
+	link    *simapi.Link
+	linkSim *LinkSimulator
+	nic     *simapi.NetworkInterface
+	host    *HostSimulator
 }
 
 func (l *linkOrNIC) String() string {
@@ -114,6 +145,12 @@ func (l *linkOrNIC) String() string {
 
 // AddDeviceSimulator creates a new devices simulator for the specified device
 func (s *Simulation) AddDeviceSimulator(dev *simapi.Device, agent DeviceAgent) (*DeviceSimulator, error) {
+	for _, port := range dev.Ports {
+		if _, err := ParsePortSpeed(port.Speed); err != nil {
+			return nil, err
+		}
+	}
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	sim := NewDeviceSimulator(dev, agent, s)
@@ -200,9 +237,9 @@ func (s *Simulation) AddLinkSimulator(link *simapi.Link) (*LinkSimulator, error)
 	sim := NewLinkSimulator(link)
 	if _, ok := s.linkSimulators[link.ID]; !ok {
 		s.linkSimulators[link.ID] = sim
-		s.usedEgressPorts[link.SrcID] = &linkOrNIC{link: link}
+		s.usedEgressPorts[link.SrcID] = &linkOrNIC{link: link, linkSim: sim}
 		if !external {
-			s.usedIngressPorts[link.TgtID] = &linkOrNIC{link: link}
+			s.usedIngressPorts[link.TgtID] = &linkOrNIC{link: link, linkSim: sim}
 		}
 		return sim, nil
 	}
@@ -266,7 +303,7 @@ func (s *Simulation) RemoveLinkSimulator(id simapi.LinkID) error {
 		delete(s.linkSimulators, id)
 		delete(s.usedEgressPorts, sim.Link.SrcID)
 		delete(s.usedIngressPorts, sim.Link.TgtID)
-		// TODO: Add stop as needed
+		sim.stopFlapping()
 		return nil
 	}
 	return errors.NewNotFound("link %s not found", id)
@@ -274,11 +311,11 @@ func (s *Simulation) RemoveLinkSimulator(id simapi.LinkID) error {
 
 // Host inventory
 
-// AddHostSimulator creates a new host simulator for the specified host
-func (s *Simulation) AddHostSimulator(host *simapi.Host) (*HostSimulator, error) {
+// AddHostSimulator creates a new host simulator for the specified host with the given behavior; nil for default
+func (s *Simulation) AddHostSimulator(host *simapi.Host, behavior *HostBehavior) (*HostSimulator, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	sim := NewHostSimulator(host, s)
+	sim := NewHostSimulator(host, behavior, s)
 
 	// Validate that the port for all NICs exists
 	for _, nic := range host.Interfaces {
@@ -298,8 +335,8 @@ func (s *Simulation) AddHostSimulator(host *simapi.Host) (*HostSimulator, error)
 	if _, ok := s.hostSimulators[host.ID]; !ok {
 		s.hostSimulators[host.ID] = sim
 		for _, nic := range host.Interfaces {
-			s.usedEgressPorts[nic.ID] = &linkOrNIC{nic: nic}
-			s.usedIngressPorts[nic.ID] = &linkOrNIC{nic: nic}
+			s.usedEgressPorts[nic.ID] = &linkOrNIC{nic: nic, host: sim}
+			s.usedIngressPorts[nic.ID] = &linkOrNIC{nic: nic, host: sim}
 		}
 		sim.Start() // start the host simulator
 		return sim, nil
@@ -328,6 +365,93 @@ func (s *Simulation) GetHostSimulator(id simapi.HostID) (*HostSimulator, error)
 	return nil, errors.NewNotFound("host %s not found", id)
 }
 
+// MoveNetworkInterface moves the specified host NIC to a different device port and has the host announce itself
+// from its new location via gratuitous ARP and, if the NIC has an IPv6 address, unsolicited neighbor advertisement.
+func (s *Simulation) MoveNetworkInterface(id simapi.HostID, mac string, portID simapi.PortID) error {
+	sim, nic, err := s.moveNetworkInterface(id, mac, portID)
+	if err != nil {
+		return err
+	}
+	log.Infof("Host %s: Moved NIC %s to port %s", id, mac, portID)
+
+	if len(nic.IpAddress) > 0 {
+		if err = sim.EmitGratuitousARP(nic); err != nil {
+			log.Warnf("Host %s: Unable to emit gratuitous ARP: %v", id, err)
+		}
+	}
+	if len(nic.Ipv6Address) > 0 {
+		if err = sim.EmitNeighborAdvertisement(nic, nil, nil); err != nil {
+			log.Warnf("Host %s: Unable to emit neighbor advertisement: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// Validates that the new port is available and re-attaches the specified host NIC to it
+func (s *Simulation) moveNetworkInterface(id simapi.HostID, mac string, portID simapi.PortID) (*HostSimulator, *simapi.NetworkInterface, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	sim, ok := s.hostSimulators[id]
+	if !ok {
+		return nil, nil, errors.NewNotFound("host %s not found", id)
+	}
+	nic := sim.GetNetworkInterfaceByMac(mac)
+	if nic == nil {
+		return nil, nil, errors.NewNotFound("nic with MAC %s not found", mac)
+	}
+	if nic.ID == portID {
+		return sim, nic, nil
+	}
+
+	// Validate that the new port exists and that it is in fact available
+	if err := s.validatePort(portID); err != nil {
+		return nil, nil, err
+	}
+	if lon, ok := s.usedEgressPorts[portID]; ok {
+		return nil, nil, errors.NewInvalid("port %s is already used for %s", portID, lon)
+	}
+	if lon, ok := s.usedIngressPorts[portID]; ok {
+		return nil, nil, errors.NewInvalid("port %s is already used for %s", portID, lon)
+	}
+
+	delete(s.usedEgressPorts, nic.ID)
+	delete(s.usedIngressPorts, nic.ID)
+	sim.lock.Lock()
+	nic.ID = portID
+	sim.lock.Unlock()
+	s.usedEgressPorts[nic.ID] = &linkOrNIC{nic: nic, host: sim}
+	s.usedIngressPorts[nic.ID] = &linkOrNIC{nic: nic, host: sim}
+	return sim, nic, nil
+}
+
+// SetHostBehavior sets the behavior of the specified host and restarts its background simulation activities
+func (s *Simulation) SetHostBehavior(id simapi.HostID, behavior *HostBehavior) error {
+	sim, err := s.GetHostSimulator(id)
+	if err != nil {
+		return err
+	}
+	sim.SetBehavior(behavior)
+	return nil
+}
+
+// JoinMulticastGroup has the specified host join the given multicast group
+func (s *Simulation) JoinMulticastGroup(id simapi.HostID, group string) error {
+	sim, err := s.GetHostSimulator(id)
+	if err != nil {
+		return err
+	}
+	return sim.JoinGroup(group)
+}
+
+// LeaveMulticastGroup has the specified host leave the given multicast group
+func (s *Simulation) LeaveMulticastGroup(id simapi.HostID, group string) error {
+	sim, err := s.GetHostSimulator(id)
+	if err != nil {
+		return err
+	}
+	return sim.LeaveGroup(group)
+}
+
 // GetRandomHostSimulator returns a random host simulator; except the specified one, if not nil
 func (s *Simulation) GetRandomHostSimulator(except *HostSimulator) *HostSimulator {
 	s.lock.RLock()
@@ -372,12 +496,104 @@ func (s *Simulation) RemoveHostSimulator(id simapi.HostID) error {
 
 // GetLinkFromPort returns the link that originates from the specified device port; nil if none
 func (s *Simulation) GetLinkFromPort(portID simapi.PortID) *simapi.Link {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	if ln, ok := s.usedEgressPorts[portID]; ok {
 		return ln.link // if the port is used for a NIC, this will be nil, which is what we want
 	}
 	return nil
 }
 
+// GetLinkSimulatorFromPort returns the simulator of the link originating from the specified port; nil if none
+func (s *Simulation) GetLinkSimulatorFromPort(portID simapi.PortID) *LinkSimulator {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if ln, ok := s.usedEgressPorts[portID]; ok {
+		return ln.linkSim
+	}
+	return nil
+}
+
+// SetLinkImpairment sets the impairment of the specified link; nil removes any impairment
+func (s *Simulation) SetLinkImpairment(id simapi.LinkID, impairment *LinkImpairment) error {
+	sim, err := s.GetLinkSimulator(id)
+	if err != nil {
+		return err
+	}
+	sim.SetImpairment(impairment)
+	return nil
+}
+
+// DisableLink disables the specified link using the given mode
+func (s *Simulation) DisableLink(id simapi.LinkID, mode LinkDisableMode) error {
+	sim, err := s.GetLinkSimulator(id)
+	if err != nil {
+		return err
+	}
+	log.Infof("Link %s: Disabling using mode %d", id, mode)
+	sim.setDisabled(true)
+	if mode == LinkDisableBidirectional {
+		if reverse := s.getReverseLinkSimulator(sim); reverse != nil {
+			reverse.setDisabled(true)
+		}
+		s.setLinkPortsStatus(sim, simapi.LinkStatus_LINK_DOWN)
+	}
+	return nil
+}
+
+// EnableLink enables the specified link, along with its reverse link and both of its end ports
+func (s *Simulation) EnableLink(id simapi.LinkID) error {
+	sim, err := s.GetLinkSimulator(id)
+	if err != nil {
+		return err
+	}
+	log.Infof("Link %s: Enabling", id)
+	sim.setDisabled(false)
+	if reverse := s.getReverseLinkSimulator(sim); reverse != nil {
+		reverse.setDisabled(false)
+	}
+	s.setLinkPortsStatus(sim, simapi.LinkStatus_LINK_UP)
+	return nil
+}
+
+// Returns the simulator of the link going in the opposite direction of the given link; nil if none
+func (s *Simulation) getReverseLinkSimulator(sim *LinkSimulator) *LinkSimulator {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if ln, ok := s.usedEgressPorts[sim.Link.TgtID]; ok && ln.linkSim != nil && ln.link.TgtID == sim.Link.SrcID {
+		return ln.linkSim
+	}
+	return nil
+}
+
+// Sets the status of the ports at both ends of the given link
+func (s *Simulation) setLinkPortsStatus(sim *LinkSimulator, status simapi.LinkStatus) {
+	ports := []simapi.PortID{sim.Link.SrcID}
+	if !isExternalLink(sim.Link) {
+		ports = append(ports, sim.Link.TgtID)
+	}
+	for _, port := range ports {
+		deviceSim, err := s.GetDeviceSimulatorForPort(port)
+		if err == nil {
+			err = deviceSim.setPortStatus(port, status)
+		}
+		if err != nil {
+			log.Warnf("Link %s: Unable to set status of port %s: %+v", sim.Link.ID, port, err)
+		}
+	}
+}
+
+// GetNetworkInterfaceFromPort returns the host simulator and its network interface attached to the specified
+// device port; nil if none
+func (s *Simulation) GetNetworkInterfaceFromPort(portID simapi.PortID) (*HostSimulator, *simapi.NetworkInterface) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if ln, ok := s.usedEgressPorts[portID]; ok && ln.nic != nil {
+		return ln.host, ln.nic
+	}
+	return nil, nil
+}
+
 // EmitARPs triggers the specified host NIC to send ARP requests for a set of IP addresses
 func (s *Simulation) EmitARPs(id simapi.HostID, mac string, ips []string) error {
 	s.lock.RLock()
@@ -22,6 +22,38 @@ func (t testAgent) Stop(mode simapi.StopMode) error {
 	return nil
 }
 
+// Loads the custom topology shared by the tests
+func loadTestTopology(t *testing.T) *topo.Topology {
+	topology := &topo.Topology{}
+	assert.NoError(t, topo.LoadTopologyFile("../../topologies/custom.yaml", topology))
+	return topology
+}
+
+// Adds the simulator of the first device of the given topology to the simulation
+func addTestDevice(t *testing.T, simulation *Simulation, topology *topo.Topology) *DeviceSimulator {
+	ds, err := simulation.AddDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), &testAgent{})
+	assert.NoError(t, err)
+	return ds
+}
+
+// Adds the simulators of all devices of the given topology to the simulation
+func addTestDevices(t *testing.T, simulation *Simulation, topology *topo.Topology) {
+	for _, dd := range topology.Devices {
+		_, err := simulation.AddDeviceSimulator(topo.ConstructDevice(dd), &testAgent{})
+		assert.NoError(t, err)
+	}
+}
+
+// Adds the simulators of all links of the given topology, in both directions, to the simulation
+func addTestLinks(t *testing.T, simulation *Simulation, topology *topo.Topology) {
+	for _, ld := range topology.Links {
+		_, err := simulation.AddLinkSimulator(topo.ConstructLink(ld))
+		assert.NoError(t, err)
+		_, err = simulation.AddLinkSimulator(topo.ConstructReverseLink(ld))
+		assert.NoError(t, err)
+	}
+}
+
 func TestSimulationBasics(t *testing.T) {
 	core := NewSimulation()
 	topology := &topo.Topology{}
@@ -70,7 +102,7 @@ func TestSimulationBasics(t *testing.T) {
 	// Fiddle with hosts
 	for _, hd := range topology.Hosts {
 		host := topo.ConstructHost(hd)
-		hsim, err := core.AddHostSimulator(host)
+		hsim, err := core.AddHostSimulator(host, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, host.ID, hsim.Host.ID)
 		hsim, err = core.GetHostSimulator(host.ID)
@@ -80,6 +112,23 @@ func TestSimulationBasics(t *testing.T) {
 	hosts := core.GetHostSimulators()
 	assert.Len(t, hosts, len(topology.Hosts))
 
+	// Move a host NIC to an unused port, and then to a port used by another NIC or link
+	err = core.MoveNetworkInterface("h111", "00:00:00:00:11:01", "leaf11/7")
+	assert.NoError(t, err)
+	hsim, nic := core.GetNetworkInterfaceFromPort("leaf11/7")
+	assert.NotNil(t, hsim)
+	assert.Equal(t, simapi.HostID("h111"), hsim.Host.ID)
+	assert.Equal(t, simapi.PortID("leaf11/7"), nic.ID)
+	hsim, _ = core.GetNetworkInterfaceFromPort("leaf11/3")
+	assert.Nil(t, hsim)
+
+	err = core.MoveNetworkInterface("h111", "00:00:00:00:11:01", "leaf11/4")
+	assert.Error(t, err)
+	err = core.MoveNetworkInterface("h111", "00:00:00:00:11:01", "leaf11/1")
+	assert.Error(t, err)
+	err = core.MoveNetworkInterface("h111", "00:00:00:00:11:99", "leaf11/8")
+	assert.Error(t, err)
+
 	rh1 := core.GetRandomHostSimulator(nil)
 	assert.NotNil(t, rh1)
 	rh2 := core.GetRandomHostSimulator(rh1)
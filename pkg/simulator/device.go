@@ -25,6 +25,8 @@ import (
 	p4info "github.com/p4lang/p4runtime/go/p4/config/v1"
 	p4api "github.com/p4lang/p4runtime/go/p4/v1"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/protobuf/proto"
+	"net"
 	"strings"
 	"sync"
 	"time"
@@ -55,8 +57,43 @@ type DeviceSimulator struct {
 	config     *configtree.Node
 	codec      *p4utils.ControllerMetadataCodec
 	puntToCPU  map[layers.EthernetType]uint32
+	puntProto  map[layers.EthernetType][]*ipProtoPunt
 	cpuActions map[uint32]*cpuAction
 	cpuTables  map[uint32]*cpuTable
+	faults     map[simapi.PortID]*portFaultState
+	lags       map[string]*LAG
+
+	transceivers  map[simapi.PortID]*config.Transceiver
+	physicalPorts map[simapi.PortID]*simapi.Port
+	breakouts     map[simapi.PortID][]*simapi.Port
+	autoNegotiate map[simapi.PortID]bool
+	// Speeds of the physical ports as added, in bits per second, which bound the total speed of their child ports
+	portCapacities map[simapi.PortID]uint64
+
+	platform            *config.Platform
+	nominalTemperatures map[string]float64
+	overheat            float64
+
+	// True until the persisted configuration is restored on start
+	pristine bool
+
+	reboot      *rebootState
+	rebootCount uint32
+	powerDown   *powerDownState
+
+	// Software pending activation on the next reboot along with the outcome of the last activation, and the injected
+	// failure of the next activation, if any
+	upgrade        UpgradeStatus
+	upgradeFailure string
+	// Versions of the installed software, which can be activated
+	installedSoftware map[string]bool
+
+	// Files on the virtual filesystem of the device, keyed by their paths
+	files map[string]*VirtualFile
+
+	// Health events of the unhealthy components and the number of health events raised so far
+	healthEvents     map[string]*healthEvent
+	healthEventCount int
 
 	cancel context.CancelFunc
 
@@ -77,10 +114,25 @@ type roleConfig struct {
 	config     *stratum.P4RoleConfig
 }
 
-// Auxiliary structure to track table that has CPU related actions and the field match related to ETH type
+// Auxiliary structure to track table that has CPU related actions and the field matches related to ETH type
+// and IP protocol
 type cpuTable struct {
 	table          *entries.Table
 	ethTypeFieldID uint32
+	ipProtoFieldID uint32
+}
+
+// Auxiliary structure to track the IP protocol ternary match of a punt rule for a given ETH type;
+// rules which do not match on IP protocol have a zero mask and apply to any IP protocol
+type ipProtoPunt struct {
+	ipProto     layers.IPProtocol
+	mask        layers.IPProtocol
+	roleAgentID uint32
+}
+
+// Returns true if the given IP protocol matches the rule value under the rule mask
+func (p *ipProtoPunt) matches(ipProto layers.IPProtocol) bool {
+	return ipProto&p.mask == p.ipProto&p.mask
 }
 
 // Auxiliary structure to track punt/copy to CPU actions and their associated role agent ID parameter ID
@@ -103,6 +155,7 @@ func NewDeviceSimulator(device *simapi.Device, agent DeviceAgent, simulation *Si
 	}
 
 	cfg := config.NewSwitchConfig(ports)
+	config.AddSystem(cfg, string(device.ID), config.DefaultSoftwareVersion)
 
 	device.PipelineInfo = &simapi.PipelineInfo{}
 	device.Connections = make([]*misc.Connection, 0)
@@ -119,15 +172,31 @@ func NewDeviceSimulator(device *simapi.Device, agent DeviceAgent, simulation *Si
 			P4DeviceConfig: []byte{},
 			Cookie:         &p4api.ForwardingPipelineConfig_Cookie{Cookie: 0},
 		},
-		roleConfigs: make(map[string]*roleConfig),
-		sdnPorts:    sdnPorts,
-		simulation:  simulation,
-		config:      cfg,
-		puntToCPU:   make(map[layers.EthernetType]uint32),
-		cpuActions:  make(map[uint32]*cpuAction),
-		cpuTables:   make(map[uint32]*cpuTable),
+		roleConfigs:  make(map[string]*roleConfig),
+		sdnPorts:     sdnPorts,
+		simulation:   simulation,
+		faults:       make(map[simapi.PortID]*portFaultState),
+		lags:         make(map[string]*LAG),
+		config:       cfg,
+		puntToCPU:    make(map[layers.EthernetType]uint32),
+		puntProto:    make(map[layers.EthernetType][]*ipProtoPunt),
+		cpuActions:   make(map[uint32]*cpuAction),
+		cpuTables:    make(map[uint32]*cpuTable),
+		transceivers: make(map[simapi.PortID]*config.Transceiver),
+
+		physicalPorts:  make(map[simapi.PortID]*simapi.Port),
+		breakouts:      make(map[simapi.PortID][]*simapi.Port),
+		autoNegotiate:  make(map[simapi.PortID]bool),
+		portCapacities: make(map[simapi.PortID]uint64),
+		pristine:       true,
+
+		installedSoftware: map[string]bool{config.DefaultSoftwareVersion: true},
+		files:             make(map[string]*VirtualFile),
+		healthEvents:      make(map[string]*healthEvent),
 	}
 	dsim.GNMIConfigurable.Configurable = dsim
+	dsim.addPortComponents()
+	dsim.addPlatformComponents()
 	return dsim
 }
 
@@ -191,10 +260,22 @@ func (ds *DeviceSimulator) SnapshotStats() *DeviceSimulator {
 func (ds *DeviceSimulator) Start(simulation *Simulation) error {
 	log.Infof("Device %s: Starting simulator", ds.Device.ID)
 
+	ds.lock.Lock()
+	powerDown := ds.powerDown
+	ds.powerDown = nil
+	config.SetBootTime(ds.config, time.Now())
+	ds.lock.Unlock()
+	if powerDown != nil {
+		ds.powerUp(powerDown)
+	}
+	ds.restoreConfig()
+
 	// Start any background simulation tasks
 	ctx, cancel := context.WithCancel(context.Background())
 	ds.cancel = cancel
-	config.SimulateTrafficCounters(ctx, 4*time.Second, ds.config)
+	config.SimulateTrafficCounters(ctx, 4*time.Second, ds.config, &ds.lock, simulation.HasTrafficMatrix, ds.sendUpdates)
+	go ds.simulatePortFaults(ctx)
+	go ds.simulatePlatform(ctx)
 
 	// Starts the simulated device agent
 	err := ds.Agent.Start(simulation, ds)
@@ -248,6 +329,7 @@ func (ds *DeviceSimulator) setPortStatus(id simapi.PortID, linkStatus simapi.Lin
 		port.Enabled = false
 	}
 	ds.updatePortStatus(port.Name, port.Enabled)
+	ds.updateLAGStatus(id)
 	if ln, ok := ds.simulation.usedEgressPorts[id]; ok {
 		if ln.link != nil {
 			ln.link.Status = linkStatus
@@ -537,6 +619,21 @@ func (ds *DeviceSimulator) ProcessPacketOut(packetOut *p4api.PacketOut, responde
 		log.Infof("Device %s: arpLayer=%+v", ds.Device.ID, arpLayer.(*layers.ARP))
 	}
 
+	// Process IPv6 neighbor solicitations
+	if nsLayer := packet.Layer(layers.LayerTypeICMPv6NeighborSolicitation); nsLayer != nil {
+		ds.processNeighborSolicitation(packet, nsLayer.(*layers.ICMPv6NeighborSolicitation), pom)
+	}
+
+	// Process IGMP and MLD membership queries
+	ds.processMembershipQuery(packet, pom)
+
+	// Process LACP packets
+	if ethLayer := packet.Layer(layers.LayerTypeEthernet); ethLayer != nil {
+		if eth := ethLayer.(*layers.Ethernet); eth.EthernetType == ethernetTypeSlowProtocols {
+			ds.processLACPPacket(eth, pom)
+		}
+	}
+
 	// Process DHCP packets
 	if dhcpLayer := packet.Layer(layers.LayerTypeDHCPv4); dhcpLayer != nil {
 		// TODO: Implement recording DHCP response packets
@@ -573,16 +670,100 @@ func (ds *DeviceSimulator) processLLDPPacket(packet gopacket.Packet, packetOut *
 	}
 
 	// Check if the given port has a link originating from it
-	if link := ds.simulation.GetLinkFromPort(egressPort.ID); link != nil {
-		// Now that we found the link, let's determine whether the link is an external one.
+	if linkSim := ds.simulation.GetLinkSimulatorFromPort(egressPort.ID); linkSim != nil {
+		// Now that we found the link, let's determine whether the link is an external one and move
+		// the packet across it, subject to the link impairment.
+		link := linkSim.Link
 		if isExternalLink(link) {
-			ds.emitLLDPPacketViaPeer(packetOut.Payload, link.TgtID)
+			linkSim.Transmit(func() { ds.emitLLDPPacketViaPeer(packetOut.Payload, link.TgtID) })
 		} else {
-			ds.EmitLLDPPacket(packetOut.Payload, link.TgtID)
+			linkSim.Transmit(func() { ds.EmitLLDPPacket(packetOut.Payload, link.TgtID) })
 		}
 	}
 }
 
+// Processes the neighbor solicitation packet-out by handing it to the simulated host attached to the egress port
+// given in the packet-out metadata (if any), so that the host can answer with a neighbor advertisement
+func (ds *DeviceSimulator) processNeighborSolicitation(packet gopacket.Packet, ns *layers.ICMPv6NeighborSolicitation,
+	pom *p4utils.PacketOutMetadata) {
+	log.Debugf("Device %s: processing neighbor solicitation for %s", ds.Device.ID, ns.TargetAddress)
+
+	ethLayer := packet.Layer(layers.LayerTypeEthernet)
+	ip6Layer := packet.Layer(layers.LayerTypeIPv6)
+	if ethLayer == nil || ip6Layer == nil {
+		return
+	}
+
+	if hostSim, nic := ds.getEgressNetworkInterface(pom); hostSim != nil {
+		hostSim.ProcessNeighborSolicitation(nic, ns, ethLayer.(*layers.Ethernet).SrcMAC, ip6Layer.(*layers.IPv6).SrcIP)
+	}
+}
+
+// Processes the IGMP or MLD membership query packet-out, if the packet is one, by handing it to the simulated host
+// attached to the egress port given in the packet-out metadata (if any), so that the host can answer with reports
+func (ds *DeviceSimulator) processMembershipQuery(packet gopacket.Packet, pom *p4utils.PacketOutMetadata) {
+	var group net.IP
+	ipv6 := false
+	switch igmp := packet.Layer(layers.LayerTypeIGMP).(type) {
+	case *layers.IGMP:
+		if igmp.Type == layers.IGMPMembershipQuery {
+			group = igmp.GroupAddress
+		}
+	case *layers.IGMPv1or2:
+		if igmp.Type == layers.IGMPMembershipQuery {
+			group = igmp.GroupAddress
+		}
+	}
+	if icmpLayer := packet.Layer(layers.LayerTypeICMPv6); icmpLayer != nil {
+		icmp := icmpLayer.(*layers.ICMPv6)
+		// Both MLDv1 and MLDv2 queries carry the maximum response code, reserved field and the group address
+		if icmp.TypeCode.Type() == layers.ICMPv6TypeMLDv1MulticastListenerQueryMessage && len(icmp.Payload) >= 20 {
+			group, ipv6 = net.IP(icmp.Payload[4:20]), true
+		}
+	}
+	if group == nil {
+		return
+	}
+
+	log.Debugf("Device %s: processing membership query for %s", ds.Device.ID, group)
+	if hostSim, nic := ds.getEgressNetworkInterface(pom); hostSim != nil {
+		hostSim.ProcessMembershipQuery(nic, group, ipv6)
+	}
+}
+
+// Processes the LACP packet-out by handing the LACPDU to the simulated host attached to the egress port
+// given in the packet-out metadata (if any), so that the host can track its LACP partner
+func (ds *DeviceSimulator) processLACPPacket(eth *layers.Ethernet, pom *p4utils.PacketOutMetadata) {
+	pdu, err := DecodeLACPDU(eth.Payload)
+	if err != nil {
+		log.Debugf("Device %s: %s", ds.Device.ID, err)
+		return
+	}
+
+	if hostSim, nic := ds.getEgressNetworkInterface(pom); hostSim != nil {
+		hostSim.ProcessLACPDU(nic, pdu)
+	}
+}
+
+// Returns the simulated host and its NIC attached to the egress port given in the packet-out metadata; nil if
+// there is no such port, if the port is disabled or if it has no host NIC attached to it
+func (ds *DeviceSimulator) getEgressNetworkInterface(pom *p4utils.PacketOutMetadata) (*HostSimulator, *simapi.NetworkInterface) {
+	egressPort, ok := ds.sdnPorts[pom.EgressPort]
+	if !ok {
+		log.Warnf("Device %s: Port %d not found", ds.Device.ID, pom.EgressPort)
+		return nil, nil
+	}
+
+	// Check if the egress port is enabled, if not, bail
+	if !egressPort.Enabled {
+		log.Debugf("Device %s: Port %s is presently disabled", ds.Device.ID, egressPort.ID)
+		return nil, nil
+	}
+
+	// Check if the given port has a host NIC attached to it
+	return ds.simulation.GetNetworkInterfaceFromPort(egressPort.ID)
+}
+
 // EmitLLDPPacket emits the specified LLDP packet on the given port with appropriately furnished metadata
 func (ds *DeviceSimulator) EmitLLDPPacket(packetData []byte, portID simapi.PortID) {
 	// If the link is local, let's emit a packet out on all the responders associated with
@@ -834,39 +1015,73 @@ func (ds *DeviceSimulator) processRead(request *p4api.Entity, sender entries.Bat
 	return nil
 }
 
-// ProcessConfigGet handles the configuration get request
+// ProcessConfigGet handles the configuration get request, returning all data with typed values
 func (ds *DeviceSimulator) ProcessConfigGet(prefix *gnmi.Path, paths []*gnmi.Path) ([]*gnmi.Notification, error) {
-	notifications := make([]*gnmi.Notification, 0, len(paths))
-	rootNode := ds.config
-	if prefix != nil {
-		ps := utils.ToString(prefix)
-		if rootNode = rootNode.GetPath(ps); rootNode == nil {
-			return nil, errors.NewInvalid("node with given prefix %s not found", ps)
+	return ds.ProcessGetRequest(&gnmi.GetRequest{Prefix: prefix, Path: paths, Type: gnmi.GetRequest_ALL, Encoding: gnmi.Encoding_PROTO})
+}
+
+// ProcessGetRequest handles the gNMI get request, honoring its data type, encoding and models; returns a not found
+// error if any of the paths does not match any data of the requested type and models
+func (ds *DeviceSimulator) ProcessGetRequest(request *gnmi.GetRequest) ([]*gnmi.Notification, error) {
+	if !config.IsSupportedEncoding(request.Encoding) {
+		return nil, errors.NewNotSupported("encoding %s not supported", request.Encoding)
+	}
+	for _, model := range request.UseModels {
+		if !config.IsSupportedModel(model) {
+			return nil, errors.NewInvalid("model %s %s not supported", model.Name, model.Version)
 		}
 	}
 
-	for _, path := range paths {
-		nodes := rootNode.FindAll(utils.ToString(path))
-		if len(nodes) > 0 {
-			notifications = append(notifications, toNotification(prefix, nodes))
+	if ds.isSchemaValidationEnabled() {
+		for _, path := range request.Path {
+			if err := config.ValidateGetPath(request.Prefix, path); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	// TODO: implement proper error handling
-	return notifications, nil
-}
+	ds.RefreshConfig()
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
 
-// Creates a notification message from the specified nodes
-func toNotification(prefix *gnmi.Path, nodes []*configtree.Node) *gnmi.Notification {
-	updates := make([]*gnmi.Update, 0, len(nodes))
-	for _, node := range nodes {
-		updates = append(updates, toUpdate(node))
+	rootNode := ds.config
+	if request.Prefix != nil {
+		ps := utils.ToString(request.Prefix)
+		if rootNode = rootNode.GetPath(ps); rootNode == nil {
+			return nil, errors.NewNotFound("node with given prefix %s not found", ps)
+		}
 	}
-	return &gnmi.Notification{
-		Timestamp: 0,
-		Prefix:    prefix,
-		Update:    updates,
+
+	notifications := make([]*gnmi.Notification, 0, len(request.Path))
+	timestamp := time.Now().UnixNano()
+	for _, path := range request.Path {
+		ps := utils.ToString(path)
+		nodes := rootNode.FindAll(ps)
+		if len(nodes) == 0 {
+			return nil, errors.NewNotFound("path %s not found", ps)
+		}
+		updates := make([]*gnmi.Update, 0, len(nodes))
+		for _, node := range nodes {
+			nodePath := utils.ToPath(node.Path())
+			if !ds.hasDataType(nodePath, request.Type) || !hasModel(nodePath, request.UseModels) {
+				continue
+			}
+			update := toUpdate(node)
+			if update.Val = config.EncodeValue(update.Val, request.Encoding); update.Val == nil {
+				return nil, errors.NewInvalid("unable to encode value of %s as %s", node.Path(), request.Encoding)
+			}
+			updates = append(updates, update)
+		}
+		if len(updates) == 0 {
+			return nil, errors.NewNotFound("path %s has no %s data of the requested models", ps, request.Type)
+		}
+		notifications = append(notifications, &gnmi.Notification{
+			Timestamp: timestamp,
+			Prefix:    request.Prefix,
+			Update:    updates,
+		})
 	}
+	return notifications, nil
 }
 
 // Creates an update message from the specified node
@@ -878,8 +1093,58 @@ func toUpdate(node *configtree.Node) *gnmi.Update {
 	}
 }
 
-// ProcessConfigSet handles the configuration set request
+// Returns true if the node at the given path holds data of the given type; config data is under config containers,
+// state data under state containers, and operational state data is state data that has no config counterpart
+func (ds *DeviceSimulator) hasDataType(path *gnmi.Path, dataType gnmi.GetRequest_DataType) bool {
+	if dataType == gnmi.GetRequest_ALL {
+		return true
+	}
+	elems := path.GetElem()
+	for i := len(elems) - 1; i >= 0; i-- {
+		switch elems[i].Name {
+		case "config":
+			return dataType == gnmi.GetRequest_CONFIG
+		case "state":
+			if dataType == gnmi.GetRequest_OPERATIONAL && i == len(elems)-2 {
+				// Leaves mirrored in the sibling config container are derived from config, not operational
+				configElems := append(append([]*gnmi.PathElem{}, elems[:i]...), &gnmi.PathElem{Name: "config"}, elems[i+1])
+				return ds.config.GetPath(utils.ToString(&gnmi.Path{Elem: configElems})) == nil
+			}
+			return dataType == gnmi.GetRequest_STATE || dataType == gnmi.GetRequest_OPERATIONAL
+		}
+	}
+	return false
+}
+
+// Returns true if the given model list is empty or contains the model which defines the node at the given path
+func hasModel(path *gnmi.Path, models []*gnmi.ModelData) bool {
+	if len(models) == 0 {
+		return true
+	}
+	name := config.ModelOf(path)
+	for _, model := range models {
+		if model.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessConfigSet handles the configuration set request; returns one result per delete, replace and update,
+// or an error if any of the paths is unknown or read-only or if the resulting port configuration cannot be
+// applied, in which case none of the changes are applied
 func (ds *DeviceSimulator) ProcessConfigSet(prefix *gnmi.Path,
+	updates []*gnmi.Update, replacements []*gnmi.Update, deletes []*gnmi.Path) ([]*gnmi.UpdateResult, error) {
+	results, err := ds.processConfigSet(prefix, updates, replacements, deletes)
+	if err == nil && ds.simulation != nil {
+		ds.simulation.recordDeviceConfig(ds.Device.ID,
+			&gnmi.SetRequest{Prefix: prefix, Update: updates, Replace: replacements, Delete: deletes})
+	}
+	return results, err
+}
+
+// Applies the configuration set request without recording it as part of the persisted configuration
+func (ds *DeviceSimulator) processConfigSet(prefix *gnmi.Path,
 	updates []*gnmi.Update, replacements []*gnmi.Update, deletes []*gnmi.Path) ([]*gnmi.UpdateResult, error) {
 	opCount := len(updates) + len(replacements) + len(deletes)
 	if opCount < 1 {
@@ -887,28 +1152,334 @@ func (ds *DeviceSimulator) ProcessConfigSet(prefix *gnmi.Path,
 	}
 	results := make([]*gnmi.UpdateResult, 0, opCount)
 
+	if ds.isSchemaValidationEnabled() {
+		if err := validateConfigSet(prefix, updates, replacements, deletes); err != nil {
+			return nil, err
+		}
+	}
+
+	enabledChanges, snapshot, err := ds.applyConfigSet(prefix, updates, replacements, deletes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the resulting port configuration before acting on it, so that the request is either applied
+	// completely or not at all
+	if err = ds.validatePortConfig(); err != nil {
+		ds.rollbackConfigSet(snapshot)
+		return nil, err
+	}
+
+	// Should acting on the ports still fail, restore the prior configuration and bring the ports back in line
+	if err = ds.applyPortChanges(enabledChanges); err != nil {
+		ds.rollbackConfigSet(snapshot)
+		ds.reconcilePorts(enabledChanges)
+		return nil, err
+	}
+	ds.notifyConfigSet()
+
+	for _, path := range deletes {
+		results = append(results, &gnmi.UpdateResult{Path: path, Op: gnmi.UpdateResult_DELETE})
+	}
+	for _, update := range replacements {
+		results = append(results, &gnmi.UpdateResult{Path: update.Path, Op: gnmi.UpdateResult_REPLACE})
+	}
+	for _, update := range updates {
+		results = append(results, &gnmi.UpdateResult{Path: update.Path, Op: gnmi.UpdateResult_UPDATE})
+	}
+	return results, nil
+}
+
+// Enables or disables the ports whose configured enabled state was changed, as the API would, and applies
+// the configured port breakout modes and speeds
+func (ds *DeviceSimulator) applyPortChanges(changes *portEnabledChanges) error {
+	for _, id := range changes.enable {
+		if err := ds.EnablePort(id); err != nil {
+			return err
+		}
+	}
+	for _, id := range changes.disable {
+		if err := ds.DisablePort(id, simapi.StopMode_CHAOTIC_STOP); err != nil {
+			return err
+		}
+	}
+	return ds.applyPortConfig()
+}
+
+// Brings the port breakout modes, speeds and the enabled state of the given ports back in line with the
+// restored configuration, after a configuration set request failed to be applied
+func (ds *DeviceSimulator) reconcilePorts(changes *portEnabledChanges) {
+	if err := ds.applyPortConfig(); err != nil {
+		log.Warnf("Device %s: Unable to restore port configuration: %+v", ds.Device.ID, err)
+	}
+	for _, id := range append(append([]simapi.PortID{}, changes.enable...), changes.disable...) {
+		ds.lock.RLock()
+		port, ok := ds.Ports[id]
+		enabled := ok && isInterfaceEnabled(ds.config, port.Name)
+		ds.lock.RUnlock()
+		if !ok {
+			continue
+		}
+		status := simapi.LinkStatus_LINK_DOWN
+		if enabled {
+			status = simapi.LinkStatus_LINK_UP
+		}
+		_ = ds.setPortStatus(id, status)
+	}
+}
+
+// Returns true if the gNMI requests are to be validated against the schema of the supported models
+func (ds *DeviceSimulator) isSchemaValidationEnabled() bool {
+	return ds.simulation != nil && ds.simulation.IsSchemaValidationEnabled()
+}
+
+// Returns an error if any of the paths or values of the configuration set request does not conform to the schema
+func validateConfigSet(prefix *gnmi.Path, updates []*gnmi.Update, replacements []*gnmi.Update, deletes []*gnmi.Path) error {
+	for _, path := range deletes {
+		if err := config.ValidateDeletePath(prefix, path); err != nil {
+			return err
+		}
+	}
+	for _, update := range append(append([]*gnmi.Update{}, replacements...), updates...) {
+		if err := config.ValidateUpdate(prefix, update.Path, update.Val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Auxiliary structure to carry the ports to be enabled or disabled as a result of a configuration set request
+type portEnabledChanges struct {
+	enable  []simapi.PortID
+	disable []simapi.PortID
+}
+
+// Validates the paths of all operations and then applies them to the configuration tree; returns the ports
+// whose configured enabled state was touched by the operations, along with a snapshot of the configuration
+// affected by the operations
+func (ds *DeviceSimulator) applyConfigSet(prefix *gnmi.Path,
+	updates []*gnmi.Update, replacements []*gnmi.Update, deletes []*gnmi.Path) (*portEnabledChanges, *configSnapshot, error) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+
 	rootNode := ds.config
 	if prefix != nil {
 		ps := utils.ToString(prefix)
 		if rootNode = rootNode.GetPath(ps); rootNode == nil {
-			return nil, errors.NewInvalid("node with given prefix %s not found", ps)
+			return nil, nil, errors.NewInvalid("node with given prefix %s not found", ps)
 		}
 	}
 
+	// Validate all paths up front, so that the request is either applied completely or not at all
+	paths := make([]*gnmi.Path, 0, len(updates)+len(replacements)+len(deletes))
 	for _, path := range deletes {
-		rootNode.DeletePath(utils.ToString(path))
+		if err := checkConfigPath(ds.config, rootNode, prefix, path, gnmi.UpdateResult_DELETE); err != nil {
+			return nil, nil, err
+		}
+		paths = append(paths, path)
+	}
+	for _, update := range replacements {
+		if err := checkConfigPath(ds.config, rootNode, prefix, update.Path, gnmi.UpdateResult_REPLACE); err != nil {
+			return nil, nil, err
+		}
+		paths = append(paths, update.Path)
+	}
+	for _, update := range updates {
+		if err := checkConfigPath(ds.config, rootNode, prefix, update.Path, gnmi.UpdateResult_UPDATE); err != nil {
+			return nil, nil, err
+		}
+		paths = append(paths, update.Path)
 	}
 
+	snapshot := takeConfigSnapshot(ds.config, rootNode, paths)
+	for _, path := range deletes {
+		rootNode.DeletePath(utils.ToString(path))
+	}
 	for _, update := range replacements {
 		rootNode.ReplacePath(utils.ToString(update.Path), update.Val)
 	}
-
 	for _, update := range updates {
 		rootNode.AddPath(utils.ToString(update.Path), update.Val)
 	}
 
-	// TODO: Implement processing of the new configuration and return proper result; error handling
-	return results, nil
+	changes := &portEnabledChanges{}
+	for _, path := range paths {
+		name, ok := enabledInterfaceName(prefix, path)
+		if !ok {
+			continue
+		}
+		for id, port := range ds.Ports {
+			if port.Name != name {
+				continue
+			}
+			if isInterfaceEnabled(ds.config, name) {
+				changes.enable = append(changes.enable, id)
+			} else {
+				changes.disable = append(changes.disable, id)
+			}
+		}
+	}
+	return changes, snapshot, nil
+}
+
+// Reflects the applied configuration set request in the state of the hostname and of the QoS, and notifies the
+// subscribers of the changes
+func (ds *DeviceSimulator) notifyConfigSet() {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if config.ApplyHostname(ds.config) {
+		ds.sendUpdates([]*configtree.Node{ds.config.GetPath("system/state/hostname")})
+	}
+	ds.sendUpdates(config.ApplyQoS(ds.config))
+}
+
+// Undoes the configuration set request captured by the given snapshot
+func (ds *DeviceSimulator) rollbackConfigSet(snapshot *configSnapshot) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	snapshot.restore()
+}
+
+// Snapshot of the configuration subtrees affected by a configuration set request, which allows undoing it
+type configSnapshot struct {
+	configRoot *configtree.Node
+	rootNode   *configtree.Node
+	entries    []*snapshotEntry
+}
+
+// Snapshot of a single configuration subtree, given by its path relative to the root node, and of the absolute
+// paths and values of its leaves; the subtree did not exist if it has no leaves
+type snapshotEntry struct {
+	path   string
+	leaves map[string]*gnmi.TypedValue
+}
+
+// Takes a snapshot of the subtrees at the given paths, relative to the given root node; for paths which do not
+// exist, the snapshot records the first missing node along the path
+func takeConfigSnapshot(configRoot *configtree.Node, rootNode *configtree.Node, paths []*gnmi.Path) *configSnapshot {
+	snapshot := &configSnapshot{configRoot: configRoot, rootNode: rootNode}
+	for _, path := range paths {
+		segments := utils.SplitPath(utils.ToString(path))
+		entry := &snapshotEntry{path: utils.JoinPath(segments), leaves: make(map[string]*gnmi.TypedValue)}
+		for i := range segments {
+			if ps := utils.JoinPath(segments[:i+1]); rootNode.GetPath(ps) == nil {
+				entry.path = ps
+				break
+			}
+		}
+		// Copy the values, as the set request may change them in place
+		for _, leaf := range rootNode.FindAll(entry.path) {
+			entry.leaves[leaf.Path()] = proto.Clone(leaf.Value()).(*gnmi.TypedValue)
+		}
+		snapshot.entries = append(snapshot.entries, entry)
+	}
+	return snapshot
+}
+
+// Restores the subtrees captured by the snapshot, in the reverse order of taking them
+func (snapshot *configSnapshot) restore() {
+	for i := len(snapshot.entries) - 1; i >= 0; i-- {
+		entry := snapshot.entries[i]
+		snapshot.rootNode.DeletePath(entry.path)
+		for path, value := range entry.leaves {
+			snapshot.configRoot.AddPath(path, value)
+		}
+	}
+}
+
+// Returns an error if the given operation on the given path, relative to the root node at the given prefix, is
+// not allowed. Updates and replacements must lead to a known configuration leaf, either of an existing container,
+// e.g. interface, or of a scheduler policy, which can be created. Deletes must lead to such a leaf or to an
+// existing node. Read-only state can be neither changed nor deleted, except for the state of the scheduler policies,
+// which follows their configuration.
+func checkConfigPath(configRoot *configtree.Node, rootNode *configtree.Node, prefix *gnmi.Path, path *gnmi.Path, op gnmi.UpdateResult_Operation) error {
+	ps := utils.ToString(path)
+	elems := config.JoinPaths(prefix, path).Elem
+	for _, elem := range elems {
+		if elem.Name == "state" {
+			return errors.NewInvalid("path %s is read-only", ps)
+		}
+	}
+
+	node := rootNode.GetPath(ps)
+	if op != gnmi.UpdateResult_UPDATE && node != nil && !config.IsSchedulerPolicyPath(elems) {
+		for _, leaf := range rootNode.FindAll(ps) {
+			if strings.Contains(strings.TrimPrefix(leaf.Path(), node.Path()), "/state/") {
+				return errors.NewInvalid("path %s contains read-only state", ps)
+			}
+		}
+	}
+	if op == gnmi.UpdateResult_DELETE && node != nil {
+		return nil
+	}
+
+	if !config.IsConfigLeaf(elems) {
+		return errors.NewNotFound("path %s is not a known configuration leaf", ps)
+	}
+	if config.IsSchedulerPolicyPath(elems) {
+		return nil
+	}
+	for i, elem := range elems {
+		if elem.Name == "config" {
+			if configRoot.GetPath(utils.ToString(&gnmi.Path{Elem: elems[:i]})) != nil {
+				return nil
+			}
+			break
+		}
+	}
+	return errors.NewNotFound("path %s not found", ps)
+}
+
+// Notifies the subscribers of the present values of the given leaf nodes; must be called with the lock held
+func (ds *DeviceSimulator) sendUpdates(nodes []*configtree.Node) {
+	if len(nodes) == 0 {
+		return
+	}
+	updates := make([]*gnmi.Update, 0, len(nodes))
+	for _, node := range nodes {
+		updates = append(updates, &gnmi.Update{Path: utils.ToPath(node.Path()), Val: node.Value()})
+	}
+	ds.GNMIConfigurable.SendToAllResponders(&gnmi.SubscribeResponse{
+		Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{Timestamp: time.Now().UnixNano(), Update: updates}},
+	})
+}
+
+// Notifies the subscribers of the removal of the given subtrees; must be called with the lock held
+func (ds *DeviceSimulator) sendDeletes(paths ...string) {
+	if len(paths) == 0 {
+		return
+	}
+	deletes := make([]*gnmi.Path, 0, len(paths))
+	for _, path := range paths {
+		deletes = append(deletes, utils.ToPath(path))
+	}
+	ds.GNMIConfigurable.SendToAllResponders(&gnmi.SubscribeResponse{
+		Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{Timestamp: time.Now().UnixNano(), Delete: deletes}},
+	})
+}
+
+// Returns the name of the interface whose config/enabled leaf is at, or is under, the given path
+func enabledInterfaceName(prefix *gnmi.Path, path *gnmi.Path) (string, bool) {
+	elems := config.JoinPaths(prefix, path).Elem
+	if len(elems) < 2 || elems[0].Name != "interfaces" || elems[1].Name != "interface" || len(elems[1].Key["name"]) == 0 {
+		return "", false
+	}
+	leaf := []string{"config", "enabled"}
+	if len(elems)-2 > len(leaf) {
+		return "", false
+	}
+	for i, elem := range elems[2:] {
+		if elem.Name != leaf[i] {
+			return "", false
+		}
+	}
+	return elems[1].Key["name"], true
+}
+
+// Returns true if the specified interface is configured to be enabled; missing configuration means enabled
+func isInterfaceEnabled(configRoot *configtree.Node, name string) bool {
+	node := configRoot.GetPath(fmt.Sprintf("interfaces/interface[name=%s]/config/enabled", name))
+	return node == nil || node.Value().GetBoolVal()
 }
 
 // HasPuntRuleForEthType returns true if the device has a table with punt-to-CPU action installed in one
@@ -920,29 +1491,56 @@ func (ds *DeviceSimulator) HasPuntRuleForEthType(ethType layers.EthernetType) (u
 	return roleAgentID, ok
 }
 
+// HasPuntRuleForIPProto returns true if the device has a table with punt-to-CPU action installed in one
+// of its tables for the given ETH type and an IP protocol match, masked as per the rule, that covers the
+// given IP protocol, or for the given ETH type regardless of IP protocol
+func (ds *DeviceSimulator) HasPuntRuleForIPProto(ethType layers.EthernetType, ipProto layers.IPProtocol) (uint32, bool) {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	for _, p := range ds.puntProto[ethType] {
+		if p.matches(ipProto) {
+			return p.roleAgentID, true
+		}
+	}
+	return 0, false
+}
+
 // Searches all tables with "acl" or "ACL" in their name and looks for rules with action punt to CPU
-// and registers the matching ETH type from the match and the role agent ID from the action parameter
+// and registers the matching ETH type, along with the IP protocol value and mask, if any, from the match
+// and the role agent ID from the action parameter
 func (ds *DeviceSimulator) checkPuntToCPU() {
 	ds.puntToCPU = make(map[layers.EthernetType]uint32)
+	ds.puntProto = make(map[layers.EthernetType][]*ipProtoPunt)
 	for _, table := range ds.cpuTables {
 		// Search entries for all CPU related tables
 		for _, entry := range table.table.Entries() {
 			action := entry.Action.GetAction()
 			if action != nil {
 				if cpuAction, ok := ds.cpuActions[action.ActionId]; ok {
-					// If entry has a CPU related action, find the match referencing ethType exact match
+					// If entry has a CPU related action, find the matches referencing ethType and ipProto
+					var ethType *layers.EthernetType
+					ipProto := &ipProtoPunt{}
 					for _, match := range entry.Match {
 						if match.FieldId == table.ethTypeFieldID && match.GetTernary() != nil {
-							// Record that this ethType has a punt-to-cpu (or related) action
-							ethType := binary.BigEndian.Uint16(match.GetTernary().Value)
-							ds.puntToCPU[layers.EthernetType(ethType)] = findRoleAgentID(action, cpuAction)
+							et := layers.EthernetType(binary.BigEndian.Uint16(match.GetTernary().Value))
+							ethType = &et
+						} else if table.ipProtoFieldID != 0 && match.FieldId == table.ipProtoFieldID && match.GetTernary() != nil {
+							ipProto.ipProto = layers.IPProtocol(p4utils.DecodeValueAsUint32(match.GetTernary().Value))
+							ipProto.mask = layers.IPProtocol(p4utils.DecodeValueAsUint32(match.GetTernary().Mask))
 						}
 					}
+
+					// Record that this ethType (and ipProto) has a punt-to-cpu (or related) action
+					if ethType != nil {
+						ipProto.roleAgentID = findRoleAgentID(action, cpuAction)
+						ds.puntToCPU[*ethType] = ipProto.roleAgentID
+						ds.puntProto[*ethType] = append(ds.puntProto[*ethType], ipProto)
+					}
 				}
 			}
 		}
 	}
-	log.Debugf("Device %s: puntToCPU=%+v", ds.Device.ID, ds.puntToCPU)
+	log.Debugf("Device %s: puntToCPU=%+v; puntProto=%+v", ds.Device.ID, ds.puntToCPU, ds.puntProto)
 }
 
 // Extract the role agent ID field value from the action parameters
@@ -974,10 +1572,14 @@ func (ds *DeviceSimulator) findPuntToCPUTables() {
 	ds.cpuTables = make(map[uint32]*cpuTable)
 	for _, table := range ds.forwardingPipelineConfig.P4Info.Tables {
 		if ds.hasCPUAction(table) {
-			if f := ds.findEthTypeMatchField(table); f != nil {
-				ds.cpuTables[table.Preamble.Id] = &cpuTable{
+			if f := ds.findMatchField(table, "eth_type"); f != nil {
+				ct := &cpuTable{
 					table:          ds.tables.Table(table.Preamble.Id),
 					ethTypeFieldID: f.Id}
+				if pf := ds.findMatchField(table, "ip_proto"); pf != nil {
+					ct.ipProtoFieldID = pf.Id
+				}
+				ds.cpuTables[table.Preamble.Id] = ct
 			}
 		}
 	}
@@ -1003,10 +1605,10 @@ func (ds *DeviceSimulator) findRoleAgentParameterID(action *p4info.Action) (uint
 	return 0, 0
 }
 
-// Finds the field match with "ethType" as its name
-func (ds *DeviceSimulator) findEthTypeMatchField(table *p4info.Table) *p4info.MatchField {
+// Finds the field match with the given name, e.g. "eth_type"
+func (ds *DeviceSimulator) findMatchField(table *p4info.Table, name string) *p4info.MatchField {
 	for _, field := range table.MatchFields {
-		if field.Name == "eth_type" {
+		if field.Name == name {
 			return field
 		}
 	}
@@ -1018,10 +1620,14 @@ func (ds *DeviceSimulator) findEthTypeMatchField(table *p4info.Table) *p4info.Ma
 // UpdateConfig should be called after the configuration tree has been updated to save the configuration and
 // to reflect it back to the controller's Config structure for easy access.
 func (ds *DeviceSimulator) UpdateConfig() {
-	// no-op here
+	if err := ds.applyPortConfig(); err != nil {
+		log.Warnf("Device %s: Unable to apply port configuration: %+v", ds.Device.ID, err)
+	}
 }
 
 // RefreshConfig refreshes the config tree state from any relevant external source state
 func (ds *DeviceSimulator) RefreshConfig() {
-	// no-op here
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	config.UpdateCurrentDatetime(ds.config)
 }
@@ -6,11 +6,13 @@ package simulator
 
 import (
 	"fmt"
+	"github.com/google/gopacket/layers"
 	"github.com/onosproject/fabric-sim/pkg/simulator/config"
 	"github.com/onosproject/fabric-sim/pkg/topo"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
 	"github.com/onosproject/onos-api/go/onos/misc"
 	"github.com/onosproject/onos-api/go/onos/stratum"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-net-lib/pkg/configtree"
 	"github.com/onosproject/onos-net-lib/pkg/gnmiutils"
 	"github.com/openconfig/gnmi/proto/gnmi"
@@ -18,12 +20,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"testing"
+	"time"
 )
 
 func TestNewDeviceSimulator(t *testing.T) {
-	topology := &topo.Topology{}
-	err := topo.LoadTopologyFile("../../topologies/custom.yaml", topology)
-	assert.NoError(t, err)
+	topology := loadTestTopology(t)
 	sim := NewDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), nil, nil)
 	assert.Equal(t, simapi.DeviceID(topology.Devices[0].ID), sim.Device.ID)
 }
@@ -112,6 +113,38 @@ func TestAddRemoveStreamResponder(t *testing.T) {
 	assert.Len(t, ds.streamResponders, 0)
 }
 
+func TestHasPuntRuleForIPProto(t *testing.T) {
+	ds := &DeviceSimulator{
+		Device:    &simapi.Device{},
+		puntToCPU: map[layers.EthernetType]uint32{layers.EthernetTypeARP: 1, layers.EthernetTypeIPv6: 2, layers.EthernetTypeIPv4: 3},
+		puntProto: map[layers.EthernetType][]*ipProtoPunt{
+			layers.EthernetTypeARP:  {{roleAgentID: 1}},
+			layers.EthernetTypeIPv6: {{ipProto: layers.IPProtocolICMPv6, mask: 0xff, roleAgentID: 2}},
+			layers.EthernetTypeIPv4: {{ipProto: layers.IPProtocolUDP, mask: 0xf0, roleAgentID: 3}},
+		},
+	}
+	id, ok := ds.HasPuntRuleForIPProto(layers.EthernetTypeIPv6, layers.IPProtocolICMPv6)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), id)
+
+	_, ok = ds.HasPuntRuleForIPProto(layers.EthernetTypeIPv6, layers.IPProtocolUDP)
+	assert.False(t, ok)
+	id, ok = ds.HasPuntRuleForEthType(layers.EthernetTypeIPv6)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), id)
+
+	id, ok = ds.HasPuntRuleForIPProto(layers.EthernetTypeARP, layers.IPProtocolICMPv6)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), id)
+
+	// UDP (0x11) and TCP (0x06) differ in the masked upper nibble; UDP and 0x1f do not
+	_, ok = ds.HasPuntRuleForIPProto(layers.EthernetTypeIPv4, layers.IPProtocolTCP)
+	assert.False(t, ok)
+	id, ok = ds.HasPuntRuleForIPProto(layers.EthernetTypeIPv4, layers.IPProtocol(0x1f))
+	assert.True(t, ok)
+	assert.Equal(t, uint32(3), id)
+}
+
 // TestDeviceProcessGet tests operation of configuration retrieval
 func TestDeviceProcessGet(t *testing.T) {
 	rootNode := CreateSwitchConfig(8)
@@ -120,7 +153,64 @@ func TestDeviceProcessGet(t *testing.T) {
 	n, err := ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath("interfaces/interface[name=4]")})
 	assert.NoError(t, err)
 	assert.Len(t, n, 1)
-	assert.Len(t, n[0].Update, 20)
+	assert.Len(t, n[0].Update, 21)
+}
+
+// TestDeviceProcessGetRequest tests that configuration retrieval honors the request options
+func TestDeviceProcessGetRequest(t *testing.T) {
+	ds := &DeviceSimulator{config: CreateSwitchConfig(8)}
+	get := func(path string, dataType gnmi.GetRequest_DataType, encoding gnmi.Encoding, models ...*gnmi.ModelData) ([]*gnmi.Notification, error) {
+		return ds.ProcessGetRequest(&gnmi.GetRequest{Path: []*gnmi.Path{gnmiutils.ToPath(path)},
+			Type: dataType, Encoding: encoding, UseModels: models})
+	}
+
+	_, err := get("interfaces/interface[name=42]", gnmi.GetRequest_ALL, gnmi.Encoding_PROTO)
+	assert.True(t, errors.IsNotFound(err))
+	_, err = get("interfaces/interface[name=4]", gnmi.GetRequest_ALL, gnmi.Encoding_ASCII)
+	assert.True(t, errors.IsNotSupported(err))
+	_, err = get("interfaces/interface[name=4]", gnmi.GetRequest_ALL, gnmi.Encoding_PROTO, &gnmi.ModelData{Name: "acme-widgets"})
+	assert.True(t, errors.IsInvalid(err))
+
+	n, err := get("interfaces/interface[name=4]", gnmi.GetRequest_CONFIG, gnmi.Encoding_PROTO)
+	assert.NoError(t, err)
+	assert.NotZero(t, n[0].Timestamp)
+	for _, update := range n[0].Update {
+		assert.Contains(t, gnmiutils.ToString(update.Path), "/config/")
+	}
+
+	// Operational state excludes the state leaves mirroring config leaves, e.g. the aggregation lag-type
+	config.AddAggregateInterface(ds.config, "lag1", []string{"1", "2"}, 1)
+	state, err := get("interfaces/interface[name=lag1]", gnmi.GetRequest_STATE, gnmi.Encoding_PROTO)
+	assert.NoError(t, err)
+	operational, err := get("interfaces/interface[name=lag1]", gnmi.GetRequest_OPERATIONAL, gnmi.Encoding_PROTO)
+	assert.NoError(t, err)
+	assert.Less(t, len(operational[0].Update), len(state[0].Update))
+	for _, update := range operational[0].Update {
+		assert.NotEqual(t, "lag-type", update.Path.Elem[len(update.Path.Elem)-1].Name)
+	}
+
+	n, err = get("interfaces/interface[name=4]/state/counters/in-octets", gnmi.GetRequest_ALL, gnmi.Encoding_JSON_IETF)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0"`, string(n[0].Update[0].Val.GetJsonIetfVal()))
+	n, err = get("interfaces/interface[name=4]/config/enabled", gnmi.GetRequest_ALL, gnmi.Encoding_JSON)
+	assert.NoError(t, err)
+	assert.Equal(t, "true", string(n[0].Update[0].Val.GetJsonVal()))
+
+	// Ethernet leaves belong to the openconfig-if-ethernet model, not to openconfig-interfaces
+	all, err := get("interfaces/interface[name=4]", gnmi.GetRequest_ALL, gnmi.Encoding_PROTO)
+	assert.NoError(t, err)
+	n, err = get("interfaces/interface[name=4]", gnmi.GetRequest_ALL, gnmi.Encoding_PROTO, &gnmi.ModelData{Name: "openconfig-interfaces"})
+	assert.NoError(t, err)
+	assert.Less(t, len(n[0].Update), len(all[0].Update))
+	for _, update := range n[0].Update {
+		assert.NotEqual(t, "ethernet", update.Path.Elem[2].Name)
+	}
+
+	// Paths whose data is all filtered out by type or models are not found either
+	_, err = get("interfaces/interface[name=4]/state", gnmi.GetRequest_CONFIG, gnmi.Encoding_PROTO)
+	assert.True(t, errors.IsNotFound(err))
+	_, err = get("interfaces/interface[name=4]/ethernet", gnmi.GetRequest_ALL, gnmi.Encoding_PROTO, &gnmi.ModelData{Name: "openconfig-interfaces"})
+	assert.True(t, errors.IsNotFound(err))
 }
 
 // TestDeviceProcessGet tests operation of configuration retrieval
@@ -132,18 +222,173 @@ func TestDeviceProcessSet(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, n[0].Update[0].Val.GetBoolVal())
 
-	_, err = ds.ProcessConfigSet(nil,
+	results, err := ds.ProcessConfigSet(nil,
 		[]*gnmi.Update{
 			{
 				Path: gnmiutils.ToPath("interfaces/interface[name=4]/config/enabled"),
 				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: false}},
 			},
-		}, []*gnmi.Update{}, []*gnmi.Path{})
+		}, []*gnmi.Update{
+			{
+				Path: gnmiutils.ToPath("interfaces/interface[name=4]/config/description"),
+				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "uplink"}},
+			},
+		}, []*gnmi.Path{gnmiutils.ToPath("interfaces/interface[name=5]/config/mtu")})
 	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, gnmi.UpdateResult_DELETE, results[0].Op)
+	assert.Equal(t, gnmi.UpdateResult_REPLACE, results[1].Op)
+	assert.Equal(t, gnmi.UpdateResult_UPDATE, results[2].Op)
 
 	n, err = ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath("interfaces/interface[name=4]/config/enabled")})
 	assert.NoError(t, err)
 	assert.False(t, n[0].Update[0].Val.GetBoolVal())
+
+	// Read-only and unknown paths are rejected without applying any of the changes
+	enabled := []*gnmi.Update{{
+		Path: gnmiutils.ToPath("interfaces/interface[name=4]/config/enabled"),
+		Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}},
+	}}
+	for _, path := range []string{"interfaces/interface[name=4]/state/oper-status", "interfaces/interface[name=42]/config/enabled",
+		"interfaces/interface[name=4]", "interfaces/interface[name=4]/config/typo", "foo/bar"} {
+		_, err = ds.ProcessConfigSet(nil, enabled, nil, []*gnmi.Path{gnmiutils.ToPath(path)})
+		assert.Error(t, err, path)
+	}
+	n, _ = ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath("interfaces/interface[name=4]/config/enabled")})
+	assert.False(t, n[0].Update[0].Val.GetBoolVal())
+	_, err = ds.ProcessConfigSet(nil, []*gnmi.Update{{
+		Path: gnmiutils.ToPath("interfaces/interface[name=4]/config/typo"),
+		Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}},
+	}}, nil, nil)
+	assert.Error(t, err)
+	_, err = ds.ProcessConfigSet(nil, nil, []*gnmi.Update{{
+		Path: gnmiutils.ToPath("interfaces/interface[name=4]"),
+		Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte("{}")}},
+	}}, nil)
+	assert.Error(t, err)
+	assert.NotNil(t, ds.config.GetPath("interfaces/interface[name=4]/state/oper-status"))
+}
+
+// TestDeviceProcessSetEnabled tests that configuring the interface enabled state enables or disables the port
+func TestDeviceProcessSetEnabled(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	link, err := core.AddLinkSimulator(topo.ConstructLink(topology.Links[0]))
+	assert.NoError(t, err)
+	ds, err := core.GetDeviceSimulatorForPort(link.Link.SrcID)
+	assert.NoError(t, err)
+	port := ds.Ports[link.Link.SrcID]
+
+	setEnabled := func(enabled bool) {
+		_, err := ds.ProcessConfigSet(&gnmi.Path{Elem: []*gnmi.PathElem{{Name: "interfaces"}}},
+			[]*gnmi.Update{{
+				Path: gnmiutils.ToPath(fmt.Sprintf("interface[name=%s]/config/enabled", port.Name)),
+				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: enabled}},
+			}}, nil, nil)
+		assert.NoError(t, err)
+	}
+	operStatus := func() string {
+		return ds.config.GetPath(fmt.Sprintf("interfaces/interface[name=%s]/state/oper-status", port.Name)).Value().GetStringVal()
+	}
+
+	setEnabled(false)
+	assert.False(t, port.Enabled)
+	assert.Equal(t, simapi.LinkStatus_LINK_DOWN, link.Link.Status)
+	assert.Equal(t, "DOWN", operStatus())
+
+	setEnabled(true)
+	assert.True(t, port.Enabled)
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, link.Link.Status)
+	assert.Equal(t, "UP", operStatus())
+
+	// Ports which fail to be acted on are brought back in line with the restored configuration
+	assert.NoError(t, ds.DisablePort(port.ID, simapi.StopMode_CHAOTIC_STOP))
+	ds.reconcilePorts(&portEnabledChanges{disable: []simapi.PortID{port.ID, "foo"}})
+	assert.True(t, port.Enabled)
+	assert.Equal(t, "UP", operStatus())
+}
+
+// TestDeviceSystem tests the system state and the configurable hostname
+func TestDeviceSystem(t *testing.T) {
+	topology := loadTestTopology(t)
+	ds := NewDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), &testAgent{}, NewSimulation())
+	value := func(path string) *gnmi.TypedValue {
+		n, err := ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath(path)})
+		assert.NoError(t, err)
+		return n[0].Update[0].Val
+	}
+	assert.Equal(t, string(ds.Device.ID), value("system/state/hostname").GetStringVal())
+	assert.Equal(t, config.DefaultSoftwareVersion, value("system/state/software-version").GetStringVal())
+	_, err := time.Parse(time.RFC3339, value("system/state/current-datetime").GetStringVal())
+	assert.NoError(t, err)
+
+	// Starting the device resets its boot time
+	created := value("system/state/boot-time").GetUintVal()
+	time.Sleep(time.Millisecond)
+	assert.NoError(t, ds.Start(ds.simulation))
+	defer ds.Stop(simapi.StopMode_ORDERLY_STOP)
+	assert.Greater(t, value("system/state/boot-time").GetUintVal(), created)
+
+	_, err = ds.ProcessConfigSet(nil, []*gnmi.Update{{
+		Path: gnmiutils.ToPath("system/config/hostname"),
+		Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "tor-1"}},
+	}}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "tor-1", value("system/state/hostname").GetStringVal())
+}
+
+// TestDeviceSchemaValidation tests validation of gNMI requests against the schema of the supported models
+func TestDeviceSchemaValidation(t *testing.T) {
+	topology := loadTestTopology(t)
+	simulation := NewSimulation()
+	ds := NewDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), &testAgent{}, simulation)
+	config.AddAggregateInterface(ds.config, "lag1", []string{"1", "2"}, 1)
+
+	// All leaves of the device configuration conform to the schema
+	nodes := append(append(ds.config.FindAll("interfaces"), ds.config.FindAll("components")...), ds.config.FindAll("system")...)
+	nodes = append(nodes, ds.config.FindAll("qos")...)
+	assert.Greater(t, len(nodes), 100)
+	for _, node := range nodes {
+		assert.NoError(t, config.ValidateUpdate(nil, gnmiutils.ToPath(node.Path()), node.Value()), node.Path())
+	}
+
+	set := func(path string, val *gnmi.TypedValue) error {
+		_, err := ds.ProcessConfigSet(nil, []*gnmi.Update{{Path: gnmiutils.ToPath(path), Val: val}}, nil, nil)
+		return err
+	}
+	get := func(path string) error {
+		_, err := ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath(path)})
+		return err
+	}
+	descriptionPath := "interfaces/interface[name=1]/config/descripton"
+	stringVal := &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "uplink"}}
+
+	// Without validation, typos are rejected, but wrong types go through
+	assert.Error(t, set(descriptionPath, stringVal))
+	assert.NoError(t, set("interfaces/interface[name=1]/config/mtu", stringVal))
+
+	simulation.SetSchemaValidation(true)
+	assert.Error(t, set(descriptionPath, stringVal))
+	assert.Error(t, set("interfaces/interface[name=1]/config/mtu", stringVal))
+	assert.Error(t, set("interfaces/interface[ifname=1]/config/mtu", stringVal))
+	assert.NoError(t, set("interfaces/interface[name=1]/config/mtu",
+		&gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"9000"`)}}))
+	assert.NoError(t, set("interfaces/interface[name=1]/config/description", stringVal))
+	assert.Error(t, get("interfaces/interface[name=1]/state/countres"))
+	assert.NoError(t, get("interfaces/interface[name=...]/state/counters"))
+
+	// Elements and keys of wildcard paths are validated as well
+	validate := func(path string) error {
+		return config.ValidateGetPath(nil, gnmiutils.ToPath(path))
+	}
+	assert.NoError(t, validate("interfaces/*/state/counters"))
+	assert.NoError(t, validate("components/component[name=1]/.../instant"))
+	assert.NoError(t, validate("qos/*/interface[interface-id=1]"))
+	assert.Error(t, validate("interfaces/*/state/countres"))
+	assert.Error(t, validate("interfaces/*/state/.../typo"))
+	assert.Error(t, validate("qos/*/interface[ifname=1]"))
+	assert.Error(t, validate("acme/*"))
 }
 
 // CreateSwitchConfig creates a test device configuration
@@ -162,3 +407,51 @@ func CreateSwitchConfig(portCount uint32) *configtree.Node {
 	}
 	return config.NewSwitchConfig(ports)
 }
+
+// TestDeviceQoS tests the simulated output queues and the configuration of scheduler policies
+func TestDeviceQoS(t *testing.T) {
+	topology := loadTestTopology(t)
+	ds := NewDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), &testAgent{}, NewSimulation())
+	value := func(path string) *gnmi.TypedValue {
+		n, err := ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath(path)})
+		assert.NoError(t, err)
+		return n[0].Update[0].Val
+	}
+	queue := "qos/interfaces/interface[interface-id=1]/output/queues/queue[name=0]/state/"
+	assert.Equal(t, "0", value(queue+"name").GetStringVal())
+	assert.Equal(t, config.DefaultSchedulerPolicy,
+		value("qos/interfaces/interface[interface-id=1]/output/scheduler-policy/state/name").GetStringVal())
+
+	// Egress traffic is carried by the queues, which drop packets when congested
+	ds.addPortTraffic("spine1/1", &portTraffic{outBytes: 100000, outPkts: 1000})
+	assert.Equal(t, uint64(400), value(queue+"transmit-pkts").GetUintVal())
+	assert.Equal(t, uint64(40000), value(queue+"transmit-octets").GetUintVal())
+	assert.Zero(t, value(queue+"dropped-pkts").GetUintVal())
+	ds.addPortTraffic("spine1/1", &portTraffic{outBytes: 100000000, outPkts: 1000000})
+	assert.Equal(t, uint64(1024), value(queue+"avg-queue-len").GetUintVal())
+	assert.Equal(t, uint64(4000), value(queue+"dropped-pkts").GetUintVal())
+
+	// New scheduler policy can be configured and applied to a port
+	stringVal := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: s}}
+	}
+	policy := "qos/scheduler-policies/scheduler-policy[name=strict]/"
+	_, err := ds.ProcessConfigSet(nil, []*gnmi.Update{
+		{Path: gnmiutils.ToPath(policy + "config/name"), Val: stringVal("strict")},
+		{Path: gnmiutils.ToPath(policy + "schedulers/scheduler[sequence=0]/config/priority"), Val: stringVal("STRICT")},
+		{Path: gnmiutils.ToPath("qos/interfaces/interface[interface-id=1]/output/scheduler-policy/config/name"), Val: stringVal("strict")},
+	}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "STRICT", value(policy+"schedulers/scheduler[sequence=0]/state/priority").GetStringVal())
+	assert.Equal(t, "strict",
+		value("qos/interfaces/interface[interface-id=1]/output/scheduler-policy/state/name").GetStringVal())
+
+	_, err = ds.ProcessConfigSet(nil, nil, nil, []*gnmi.Path{gnmiutils.ToPath(policy + "schedulers/scheduler[sequence=0]/config/priority")})
+	assert.NoError(t, err)
+	assert.Nil(t, ds.config.GetPath(policy+"schedulers/scheduler[sequence=0]/state/priority"))
+
+	// Queue statistics are read-only
+	_, err = ds.ProcessConfigSet(nil, []*gnmi.Update{{Path: gnmiutils.ToPath(queue + "dropped-pkts"),
+		Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 0}}}}, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"time"
+)
+
+const faultInterval = time.Second
+
+// PortFaults describes the rates, in occurrences per second, at which errors and discards accrue on a port
+type PortFaults struct {
+	// FCSErrors is the rate of received frames with bad CRC; these count towards the input errors as well
+	FCSErrors float64
+	// InErrors is the rate of received frames with errors other than bad CRC
+	InErrors float64
+	// InDiscards is the rate of received frames discarded without an error
+	InDiscards float64
+	// OutDiscards is the rate of transmitted frames discarded without an error
+	OutDiscards float64
+	// DownThreshold is the number of errors and discards accrued since the faults were set, after which the port
+	// goes oper-DOWN; zero keeps the port up
+	DownThreshold uint64
+}
+
+// Error and discard counters affected by the port faults, in the order of the accrued amounts
+var faultCounters = []string{"in-fcs-errors", "in-errors", "in-discards", "out-discards"}
+
+// Auxiliary structure to track the faults injected into a single port
+type portFaultState struct {
+	faults   *PortFaults
+	residues [4]float64
+	total    uint64
+}
+
+// NewPortFaults creates new port faults from the given rates and oper-DOWN threshold
+func NewPortFaults(fcsErrors float64, inErrors float64, inDiscards float64, outDiscards float64, downThreshold uint64) (*PortFaults, error) {
+	for _, rate := range []float64{fcsErrors, inErrors, inDiscards, outDiscards} {
+		if rate < 0 {
+			return nil, errors.NewInvalid("invalid port fault rate %f", rate)
+		}
+	}
+	return &PortFaults{FCSErrors: fcsErrors, InErrors: inErrors, InDiscards: inDiscards, OutDiscards: outDiscards,
+		DownThreshold: downThreshold}, nil
+}
+
+// SetPortFaults injects the given faults into the specified port, replacing any faults injected earlier;
+// nil faults stop the injection.
+func (s *Simulation) SetPortFaults(id simapi.PortID, faults *PortFaults) error {
+	deviceSim, err := s.GetDeviceSimulatorForPort(id)
+	if err != nil {
+		return err
+	}
+	return deviceSim.SetPortFaults(id, faults)
+}
+
+// SetLinkFaults injects the given faults into the port at the receiving end of the specified link, replacing
+// any faults injected earlier; nil faults stop the injection
+func (s *Simulation) SetLinkFaults(id simapi.LinkID, faults *PortFaults) error {
+	linkSim, err := s.GetLinkSimulator(id)
+	if err != nil {
+		return err
+	}
+	return s.SetPortFaults(linkSim.Link.TgtID, faults)
+}
+
+// GetPortFaults returns the faults injected into the specified port; nil if there are none
+func (s *Simulation) GetPortFaults(id simapi.PortID) (*PortFaults, error) {
+	deviceSim, err := s.GetDeviceSimulatorForPort(id)
+	if err != nil {
+		return nil, err
+	}
+	return deviceSim.GetPortFaults(id)
+}
+
+// SetPortFaults injects the given faults into the specified port, replacing any faults injected earlier;
+// nil faults stop the injection
+func (ds *DeviceSimulator) SetPortFaults(id simapi.PortID, faults *PortFaults) error {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if _, ok := ds.Ports[id]; !ok {
+		return errors.NewNotFound("port %s not found", id)
+	}
+	if faults == nil {
+		log.Infof("Device %s: Clearing faults of port %s", ds.Device.ID, id)
+		delete(ds.faults, id)
+		ds.evaluateHealth()
+		return nil
+	}
+	log.Infof("Device %s: Injecting faults %+v into port %s", ds.Device.ID, faults, id)
+	ds.faults[id] = &portFaultState{faults: faults}
+	ds.evaluateHealth()
+	return nil
+}
+
+// GetPortFaults returns the faults injected into the specified port; nil if there are none
+func (ds *DeviceSimulator) GetPortFaults(id simapi.PortID) (*PortFaults, error) {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	if _, ok := ds.Ports[id]; !ok {
+		return nil, errors.NewNotFound("port %s not found", id)
+	}
+	if state, ok := ds.faults[id]; ok {
+		return state.faults, nil
+	}
+	return nil, nil
+}
+
+// Periodically accrues the errors and discards of all ports with injected faults, until cancelled
+func (ds *DeviceSimulator) simulatePortFaults(ctx context.Context) {
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(faultInterval):
+			now := time.Now()
+			ds.injectPortFaults(now.Sub(last))
+			last = now
+		}
+	}
+}
+
+// Accrues the errors and discards of all enabled ports with injected faults for the given time period and takes
+// down any ports past their threshold
+func (ds *DeviceSimulator) injectPortFaults(elapsed time.Duration) {
+	failed := make([]simapi.PortID, 0)
+	ds.lock.Lock()
+	for id, state := range ds.faults {
+		port := ds.Ports[id]
+		if !port.Enabled {
+			continue
+		}
+		f := state.faults
+		amounts := make(map[string]uint64)
+		for i, rate := range []float64{f.FCSErrors, f.InErrors, f.InDiscards, f.OutDiscards} {
+			state.residues[i] += rate * elapsed.Seconds()
+			amount := uint64(state.residues[i])
+			state.residues[i] -= float64(amount)
+			amounts[faultCounters[i]] += amount
+			state.total += amount
+		}
+		// Frames with bad CRC are input errors as well
+		amounts["in-errors"] += amounts["in-fcs-errors"]
+		for counter, amount := range amounts {
+			config.AddPortCounter(ds.config, port.Name, counter, amount)
+		}
+		if f.DownThreshold > 0 && state.total >= f.DownThreshold {
+			failed = append(failed, id)
+		}
+	}
+	ds.lock.Unlock()
+
+	for _, id := range failed {
+		log.Warnf("Device %s: Port %s exceeded its error threshold", ds.Device.ID, id)
+		_ = ds.setPortStatus(id, simapi.LinkStatus_LINK_DOWN)
+	}
+}
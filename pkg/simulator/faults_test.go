@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewPortFaults(t *testing.T) {
+	f, err := NewPortFaults(1.5, 0, 2, 0, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, f.FCSErrors)
+	assert.Equal(t, uint64(100), f.DownThreshold)
+
+	_, err = NewPortFaults(0, -1, 0, 0, 0)
+	assert.Error(t, err)
+}
+
+func TestPortFaults(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	link, err := core.AddLinkSimulator(topo.ConstructLink(topology.Links[0]))
+	assert.NoError(t, err)
+
+	ds, err := core.GetDeviceSimulatorForPort(link.Link.TgtID)
+	assert.NoError(t, err)
+	counter := func(name string) uint64 {
+		path := fmt.Sprintf("interfaces/interface[name=%s]/state/counters/%s", ds.Ports[link.Link.TgtID].Name, name)
+		return ds.config.GetPath(path).Value().GetUintVal()
+	}
+
+	faults, err := NewPortFaults(1.5, 1, 0, 2, 15)
+	assert.NoError(t, err)
+	assert.NoError(t, core.SetLinkFaults(link.Link.ID, faults))
+	f, err := core.GetPortFaults(link.Link.TgtID)
+	assert.NoError(t, err)
+	assert.Equal(t, faults, f)
+
+	// Fractional rates accrue across periods
+	ds.injectPortFaults(time.Second)
+	assert.Equal(t, uint64(1), counter("in-fcs-errors"))
+	assert.Equal(t, uint64(2), counter("in-errors"))
+	assert.Equal(t, uint64(2), counter("out-discards"))
+	assert.Zero(t, counter("in-discards"))
+	ds.injectPortFaults(time.Second)
+	assert.Equal(t, uint64(3), counter("in-fcs-errors"))
+	assert.Equal(t, uint64(5), counter("in-errors"))
+	assert.True(t, ds.Ports[link.Link.TgtID].Enabled)
+
+	// Port goes down past the threshold and stops accruing errors
+	ds.injectPortFaults(2 * time.Second)
+	assert.False(t, ds.Ports[link.Link.TgtID].Enabled)
+	assert.Equal(t, "DOWN", ds.config.GetPath(fmt.Sprintf("interfaces/interface[name=%s]/state/oper-status",
+		ds.Ports[link.Link.TgtID].Name)).Value().GetStringVal())
+	ds.injectPortFaults(time.Second)
+	assert.Equal(t, uint64(6), counter("in-fcs-errors"))
+
+	assert.NoError(t, core.SetPortFaults(link.Link.TgtID, nil))
+	f, err = core.GetPortFaults(link.Link.TgtID)
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+
+	assert.Error(t, core.SetLinkFaults("foo", faults))
+	assert.Error(t, core.SetPortFaults("foo/1", faults))
+}
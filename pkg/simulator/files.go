@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnoi/types"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Permissions of the directories of the virtual filesystem, which exist implicitly as long as they hold any files
+const dirPermissions = 0755
+
+// VirtualFile is a file on the virtual filesystem of a device, or one of its directories, which have no contents
+// and were last modified when any of the files within them were
+type VirtualFile struct {
+	Path         string
+	Contents     []byte
+	Permissions  uint32
+	LastModified time.Time
+	Directory    bool
+}
+
+// PutFile verifies the given contents against the given hash and writes them to the file at the given absolute path
+// on the virtual filesystem of the device, replacing any previous contents
+func (ds *DeviceSimulator) PutFile(filePath string, permissions uint32, contents []byte, hash *types.HashType) error {
+	filePath, err := cleanFilePath(filePath)
+	if err != nil {
+		return err
+	}
+	if err = verifyHash(contents, hash); err != nil {
+		return err
+	}
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if err = ds.checkFilePath(filePath); err != nil {
+		return err
+	}
+	ds.putFile(filePath, permissions, contents)
+	return nil
+}
+
+// Returns an error if a file cannot be written at the given clean path, because the path is a directory or because
+// one of its parent directories is a file; must be called with the lock held
+func (ds *DeviceSimulator) checkFilePath(filePath string) error {
+	if filePath == "/" {
+		return errors.NewInvalid("path %s is a directory", filePath)
+	}
+	for p := range ds.files {
+		if strings.HasPrefix(p, filePath+"/") {
+			return errors.NewInvalid("path %s is a directory", filePath)
+		}
+	}
+	for dir := path.Dir(filePath); dir != "/"; dir = path.Dir(dir) {
+		if _, ok := ds.files[dir]; ok {
+			return errors.NewInvalid("parent %s of path %s is a file", dir, filePath)
+		}
+	}
+	return nil
+}
+
+// Writes the given contents to the file at the given clean path; must be called with the lock held
+func (ds *DeviceSimulator) putFile(filePath string, permissions uint32, contents []byte) {
+	log.Infof("Device %s: Writing file %s (%d bytes)", ds.Device.ID, filePath, len(contents))
+	ds.files[filePath] = &VirtualFile{
+		Path:         filePath,
+		Contents:     append([]byte{}, contents...),
+		Permissions:  permissions,
+		LastModified: time.Now(),
+	}
+}
+
+// GetFile returns the file at the given absolute path on the virtual filesystem of the device
+func (ds *DeviceSimulator) GetFile(filePath string) (*VirtualFile, error) {
+	filePath, err := cleanFilePath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	file, ok := ds.files[filePath]
+	if !ok {
+		return nil, errors.NewNotFound("file %s not found", filePath)
+	}
+	f := *file
+	return &f, nil
+}
+
+// StatFiles returns the file at the given absolute path on the virtual filesystem of the device or, if the path is
+// a directory, the files and directories immediately within that directory, sorted by their paths
+func (ds *DeviceSimulator) StatFiles(filePath string) ([]*VirtualFile, error) {
+	filePath, err := cleanFilePath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	if file, ok := ds.files[filePath]; ok {
+		f := *file
+		return []*VirtualFile{&f}, nil
+	}
+
+	files := make([]*VirtualFile, 0)
+	dirs := make(map[string]*VirtualFile)
+	dir := strings.TrimSuffix(filePath, "/") + "/"
+	for p, file := range ds.files {
+		if !strings.HasPrefix(p, dir) {
+			continue
+		}
+		name, _, nested := strings.Cut(strings.TrimPrefix(p, dir), "/")
+		if !nested {
+			f := *file
+			files = append(files, &f)
+			continue
+		}
+		d, ok := dirs[name]
+		if !ok {
+			d = &VirtualFile{Path: dir + name, Permissions: dirPermissions, Directory: true}
+			dirs[name] = d
+			files = append(files, d)
+		}
+		if file.LastModified.After(d.LastModified) {
+			d.LastModified = file.LastModified
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.NewNotFound("file or directory %s not found", filePath)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, nil
+}
+
+// RemoveFile removes the file at the given absolute path from the virtual filesystem of the device
+func (ds *DeviceSimulator) RemoveFile(filePath string) error {
+	filePath, err := cleanFilePath(filePath)
+	if err != nil {
+		return err
+	}
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if _, ok := ds.files[filePath]; !ok {
+		return errors.NewNotFound("file %s not found", filePath)
+	}
+	log.Infof("Device %s: Removing file %s", ds.Device.ID, filePath)
+	delete(ds.files, filePath)
+	return nil
+}
+
+// Returns the given absolute path in its clean form
+func cleanFilePath(filePath string) (string, error) {
+	if !path.IsAbs(filePath) {
+		return "", errors.NewInvalid("path %s is not absolute", filePath)
+	}
+	return path.Clean(filePath), nil
+}
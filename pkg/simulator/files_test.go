@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	"github.com/openconfig/gnoi/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestVirtualFiles(t *testing.T) {
+	simulation := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, simulation, topology)
+
+	md5Hash := func(contents []byte) *types.HashType {
+		sum := md5.Sum(contents)
+		return &types.HashType{Method: types.HashType_MD5, Hash: sum[:]}
+	}
+	contents := []byte("hostname spine1")
+
+	assert.True(t, errors.IsInvalid(ds.PutFile("etc/config", 0644, contents, md5Hash(contents))))
+	assert.True(t, errors.IsInvalid(ds.PutFile("/etc/config", 0644, contents, md5Hash([]byte("other")))))
+	assert.NoError(t, ds.PutFile("/etc/config", 0644, contents, md5Hash(contents)))
+	assert.NoError(t, ds.PutFile("/etc/motd", 0600, []byte("welcome"), md5Hash([]byte("welcome"))))
+	assert.True(t, errors.IsInvalid(ds.PutFile("/etc", 0644, contents, md5Hash(contents))))
+	assert.True(t, errors.IsInvalid(ds.PutFile("/etc/config/extra", 0644, contents, md5Hash(contents))))
+	assert.True(t, errors.IsInvalid(ds.PutFile("/", 0644, contents, md5Hash(contents))))
+	assert.NoError(t, ds.PutFile("/etc/ssl/cert.pem", 0600, contents, md5Hash(contents)))
+
+	// Software packages are subject to the same checks
+	sum := sha256.Sum256(contents)
+	err := ds.SetPackage(&gnoiapi.Package{Filename: "/etc/motd/os.bin", Version: "2.0.0"}, contents,
+		&types.HashType{Method: types.HashType_SHA256, Hash: sum[:]})
+	assert.True(t, errors.IsInvalid(err))
+	assert.False(t, ds.IsSoftwareInstalled("2.0.0"))
+
+	file, err := ds.GetFile("/etc/../etc/config")
+	assert.NoError(t, err)
+	assert.Equal(t, "/etc/config", file.Path)
+	assert.Equal(t, contents, file.Contents)
+	assert.Equal(t, uint32(0644), file.Permissions)
+
+	// Directories list only their immediate files and subdirectories
+	files, err := ds.StatFiles("/etc")
+	assert.NoError(t, err)
+	assert.Len(t, files, 3)
+	assert.Equal(t, "/etc/config", files[0].Path)
+	assert.Equal(t, "/etc/motd", files[1].Path)
+	assert.Len(t, files[1].Contents, 7)
+	assert.Equal(t, "/etc/ssl", files[2].Path)
+	assert.True(t, files[2].Directory)
+	assert.False(t, files[2].LastModified.IsZero())
+	files, err = ds.StatFiles("/")
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "/etc", files[0].Path)
+
+	files, err = ds.StatFiles("/etc/motd")
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	assert.NoError(t, ds.RemoveFile("/etc/config"))
+	_, err = ds.GetFile("/etc/config")
+	assert.True(t, errors.IsNotFound(err))
+	assert.True(t, errors.IsNotFound(ds.RemoveFile("/etc/config")))
+	_, err = ds.StatFiles("/var")
+	assert.True(t, errors.IsNotFound(err))
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"time"
+)
+
+// LinkFlapSchedule describes how many times and how often a link goes down and back up
+type LinkFlapSchedule struct {
+	// Count is the number of flaps
+	Count int
+	// Period is the time between the starts of two consecutive flaps
+	Period time.Duration
+	// Down is the time the link stays down during each flap
+	Down time.Duration
+	// Delay is the time before the first flap
+	Delay time.Duration
+}
+
+// LinkFlap records a single flap of a link
+type LinkFlap struct {
+	Down time.Time
+	// Up is zero while the link is still down
+	Up time.Time
+}
+
+// NewLinkFlapSchedule creates a new link flap schedule from the given count and durations; the down duration
+// must be shorter than the period
+func NewLinkFlapSchedule(count int, period string, down string, delay string) (*LinkFlapSchedule, error) {
+	schedule := &LinkFlapSchedule{Count: count}
+	var err error
+	if count <= 0 {
+		return nil, errors.NewInvalid("invalid link flap count %d", count)
+	}
+	if schedule.Period, err = parseDuration(period); err != nil {
+		return nil, errors.NewInvalid("invalid link flap period %s", period)
+	}
+	if schedule.Down, err = parseDuration(down); err != nil || schedule.Down == 0 {
+		return nil, errors.NewInvalid("invalid link flap down duration %s", down)
+	}
+	if schedule.Delay, err = parseDuration(delay); err != nil {
+		return nil, errors.NewInvalid("invalid link flap delay %s", delay)
+	}
+	if count > 1 && schedule.Period <= schedule.Down {
+		return nil, errors.NewInvalid("link flap period %s must exceed the down duration %s", period, down)
+	}
+	return schedule, nil
+}
+
+// FlapLinks makes each of the specified links flap according to the given schedule, replacing any schedule
+// the links may have been already following
+func (s *Simulation) FlapLinks(ids []simapi.LinkID, schedule *LinkFlapSchedule) error {
+	sims := make([]*LinkSimulator, 0, len(ids))
+	for _, id := range ids {
+		sim, err := s.GetLinkSimulator(id)
+		if err != nil {
+			return err
+		}
+		sims = append(sims, sim)
+	}
+	for _, sim := range sims {
+		sim.startFlapping(s, schedule)
+	}
+	return nil
+}
+
+// StopFlappingLink stops the specified link from flapping; the link is brought up, if it was down
+func (s *Simulation) StopFlappingLink(id simapi.LinkID) error {
+	sim, err := s.GetLinkSimulator(id)
+	if err != nil {
+		return err
+	}
+	sim.stopFlapping()
+	return nil
+}
+
+// GetLinkFlapHistory returns the history of flaps of the specified link
+func (s *Simulation) GetLinkFlapHistory(id simapi.LinkID) ([]LinkFlap, error) {
+	sim, err := s.GetLinkSimulator(id)
+	if err != nil {
+		return nil, err
+	}
+	return sim.FlapHistory(), nil
+}
+
+// FlapHistory returns the history of flaps of the link
+func (ls *LinkSimulator) FlapHistory() []LinkFlap {
+	ls.lock.RLock()
+	defer ls.lock.RUnlock()
+	history := make([]LinkFlap, len(ls.flaps))
+	copy(history, ls.flaps)
+	return history
+}
+
+// Stops any prior flapping and starts flapping per the given schedule under the same lock hold, so that
+// concurrent calls cannot leave behind a stray flapping goroutine
+func (ls *LinkSimulator) startFlapping(simulation *Simulation, schedule *LinkFlapSchedule) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	ls.stopFlappingLocked()
+	log.Infof("Link %s: Flapping %d times every %s for %s", ls.Link.ID, schedule.Count, schedule.Period, schedule.Down)
+	ls.flapDone = make(chan string)
+	go ls.flap(simulation, schedule, ls.flapDone)
+}
+
+func (ls *LinkSimulator) stopFlapping() {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	ls.stopFlappingLocked()
+}
+
+// Stops the flapping goroutine, if any; the link lock must be held
+func (ls *LinkSimulator) stopFlappingLocked() {
+	if ls.flapDone != nil {
+		close(ls.flapDone)
+		ls.flapDone = nil
+	}
+}
+
+// Takes the link down and back up per the given schedule, until done; the link is left up when done early
+func (ls *LinkSimulator) flap(simulation *Simulation, schedule *LinkFlapSchedule, done chan string) {
+	select {
+	case <-time.After(schedule.Delay):
+	case <-done:
+		return
+	}
+	for i := 0; i < schedule.Count; i++ {
+		ls.setFlapStatus(simulation, simapi.LinkStatus_LINK_DOWN)
+		select {
+		case <-time.After(schedule.Down):
+		case <-done:
+			ls.setFlapStatus(simulation, simapi.LinkStatus_LINK_UP)
+			return
+		}
+		ls.setFlapStatus(simulation, simapi.LinkStatus_LINK_UP)
+		if i < schedule.Count-1 {
+			select {
+			case <-time.After(schedule.Period - schedule.Down):
+			case <-done:
+				return
+			}
+		}
+	}
+	log.Infof("Link %s: Finished flapping", ls.Link.ID)
+}
+
+// Sets the status of the ports at both ends of the link and records the flap
+func (ls *LinkSimulator) setFlapStatus(simulation *Simulation, status simapi.LinkStatus) {
+	simulation.setLinkPortsStatus(ls, status)
+
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	if status == simapi.LinkStatus_LINK_DOWN {
+		ls.flaps = append(ls.flaps, LinkFlap{Down: time.Now()})
+	} else if n := len(ls.flaps); n > 0 && ls.flaps[n-1].Up.IsZero() {
+		ls.flaps[n-1].Up = time.Now()
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewLinkFlapSchedule(t *testing.T) {
+	s, err := NewLinkFlapSchedule(3, "10s", "2s", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Second, s.Period)
+	assert.Equal(t, 2*time.Second, s.Down)
+
+	_, err = NewLinkFlapSchedule(1, "", "2s", "1m")
+	assert.NoError(t, err)
+	_, err = NewLinkFlapSchedule(0, "10s", "2s", "")
+	assert.Error(t, err)
+	_, err = NewLinkFlapSchedule(3, "1s", "2s", "")
+	assert.Error(t, err)
+	_, err = NewLinkFlapSchedule(3, "10s", "", "")
+	assert.Error(t, err)
+}
+
+func TestFlapLinks(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	link := topo.ConstructLink(topology.Links[0])
+	reverse := topo.ConstructReverseLink(topology.Links[0])
+	_, err := core.AddLinkSimulator(link)
+	assert.NoError(t, err)
+	reverseSim, err := core.AddLinkSimulator(reverse)
+	assert.NoError(t, err)
+
+	assert.Error(t, core.FlapLinks([]simapi.LinkID{link.ID, "foo"}, &LinkFlapSchedule{Count: 1, Down: time.Second}))
+
+	schedule, err := NewLinkFlapSchedule(2, "300ms", "200ms", "")
+	assert.NoError(t, err)
+	assert.NoError(t, core.FlapLinks([]simapi.LinkID{link.ID}, schedule))
+
+	// Both ends go down during each flap and the statuses of both link directions follow
+	assert.Eventually(t, func() bool {
+		history, _ := core.GetLinkFlapHistory(link.ID)
+		return len(history) == 1 && history[0].Up.IsZero()
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, simapi.LinkStatus_LINK_DOWN, link.Status)
+	assert.Equal(t, simapi.LinkStatus_LINK_DOWN, reverse.Status)
+
+	assert.Eventually(t, func() bool {
+		history, _ := core.GetLinkFlapHistory(link.ID)
+		return len(history) == 2 && !history[1].Up.IsZero()
+	}, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, link.Status)
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, reverse.Status)
+
+	// Stopping the link while it is down brings it back up
+	assert.NoError(t, core.FlapLinks([]simapi.LinkID{link.ID}, &LinkFlapSchedule{Count: 1, Down: time.Hour}))
+	assert.Eventually(t, func() bool {
+		history, _ := core.GetLinkFlapHistory(link.ID)
+		return len(history) == 3
+	}, time.Second, 5*time.Millisecond)
+	assert.NoError(t, core.StopFlappingLink(link.ID))
+	assert.Eventually(t, func() bool {
+		history, _ := core.GetLinkFlapHistory(link.ID)
+		return !history[2].Up.IsZero()
+	}, time.Second, 5*time.Millisecond)
+	assert.Error(t, core.StopFlappingLink("foo"))
+
+	// Concurrent schedules replace each other without leaving any stray flapping behind
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, core.FlapLinks([]simapi.LinkID{link.ID}, &LinkFlapSchedule{Count: 1, Down: time.Hour, Delay: time.Hour}))
+		}()
+	}
+	wg.Wait()
+	assert.NoError(t, core.StopFlappingLink(link.ID))
+	history, _ := core.GetLinkFlapHistory(link.ID)
+	assert.Len(t, history, 3)
+
+	// Removing the link stops it from flapping and brings it back up
+	assert.NoError(t, core.FlapLinks([]simapi.LinkID{reverse.ID}, &LinkFlapSchedule{Count: 1, Down: time.Hour}))
+	assert.Eventually(t, func() bool {
+		history, _ := core.GetLinkFlapHistory(reverse.ID)
+		return len(history) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.NoError(t, core.RemoveLinkSimulator(reverse.ID))
+	assert.Eventually(t, func() bool {
+		reverseSim.lock.RLock()
+		defer reverseSim.lock.RUnlock()
+		return reverseSim.flapDone == nil && !reverseSim.flaps[0].Up.IsZero()
+	}, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnoi/healthz"
+	"github.com/openconfig/gnoi/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+// Event raised when a component becomes unhealthy, as soon as the platform state or the port faults change; it lasts
+// until the component becomes healthy again
+type healthEvent struct {
+	id           string
+	created      time.Time
+	acknowledged bool
+}
+
+// GetComponentHealth returns the health of the named component of the device, along with the health of its
+// subcomponents. Fans and power supplies are unhealthy when failed, temperature sensors when past their alarm
+// threshold and ports when faults are injected into them; the linecard and the chassis are unhealthy when any of
+// their subcomponents are.
+func (ds *DeviceSimulator) GetComponentHealth(name string) (*healthz.ComponentStatus, error) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	healthy, children := ds.evaluateHealth()
+	if _, ok := healthy[name]; !ok {
+		return nil, errors.NewNotFound("component %s not found", name)
+	}
+	return ds.componentStatus(name, healthy, children), nil
+}
+
+// ListComponentHealth returns the health events of the named component of the device and of all its subcomponents,
+// optionally including the acknowledged events
+func (ds *DeviceSimulator) ListComponentHealth(name string, includeAcknowledged bool) ([]*healthz.ComponentStatus, error) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	healthy, children := ds.evaluateHealth()
+	if _, ok := healthy[name]; !ok {
+		return nil, errors.NewNotFound("component %s not found", name)
+	}
+
+	statuses := make([]*healthz.ComponentStatus, 0)
+	var list func(name string)
+	list = func(name string) {
+		if event, ok := ds.healthEvents[name]; ok && (includeAcknowledged || !event.acknowledged) {
+			statuses = append(statuses, ds.componentStatus(name, healthy, nil))
+		}
+		for _, child := range children[name] {
+			list(child)
+		}
+	}
+	list(name)
+	return statuses, nil
+}
+
+// AcknowledgeComponentHealth acknowledges the health event with the given ID of the named component of the device
+func (ds *DeviceSimulator) AcknowledgeComponentHealth(name string, id string) (*healthz.ComponentStatus, error) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	healthy, _ := ds.evaluateHealth()
+	if _, ok := healthy[name]; !ok {
+		return nil, errors.NewNotFound("component %s not found", name)
+	}
+	event, ok := ds.healthEvents[name]
+	if !ok || event.id != id {
+		return nil, errors.NewNotFound("health event %s of component %s not found", id, name)
+	}
+	event.acknowledged = true
+	return ds.componentStatus(name, healthy, nil), nil
+}
+
+// Evaluates the health of all components and returns it along with the subcomponents of each component; raises
+// health events for components which became unhealthy and clears those of components which became healthy again;
+// must be called with the lock held
+func (ds *DeviceSimulator) evaluateHealth() (map[string]bool, map[string][]string) {
+	healthy := make(map[string]bool)
+	children := make(map[string][]string)
+	add := func(name string, parent string, ok bool) {
+		healthy[name] = ok
+		children[parent] = append(children[parent], name)
+	}
+
+	for _, fan := range ds.platform.Fans {
+		add(fan.Name, config.ChassisName, !fan.Failed)
+	}
+	for _, psu := range ds.platform.PSUs {
+		add(psu.Name, config.ChassisName, !psu.Failed)
+	}
+	for _, sensor := range ds.platform.Sensors {
+		add(sensor.Name, config.ChassisName, !sensor.Alarm())
+	}
+	add(config.CPUName, config.LinecardName, true)
+
+	faulty := make(map[string]bool)
+	for id := range ds.faults {
+		if port, ok := ds.physicalPorts[physicalPortID(id)]; ok {
+			faulty[config.PortComponentName(port.Name)] = true
+		}
+	}
+	for _, port := range ds.physicalPorts {
+		name := config.PortComponentName(port.Name)
+		add(name, config.LinecardName, !faulty[name])
+	}
+
+	// Linecard and chassis are as healthy as their subcomponents
+	for _, name := range []string{config.LinecardName, config.ChassisName} {
+		ok := true
+		for _, child := range children[name] {
+			ok = ok && healthy[child]
+		}
+		if name == config.LinecardName {
+			add(name, config.ChassisName, ok)
+		} else {
+			healthy[name] = ok
+		}
+	}
+	for _, names := range children {
+		sort.Strings(names)
+	}
+
+	for name, ok := range healthy {
+		event, raised := ds.healthEvents[name]
+		if !ok && !raised {
+			ds.healthEventCount++
+			event = &healthEvent{id: fmt.Sprintf("%s-%d", name, ds.healthEventCount), created: time.Now()}
+			ds.healthEvents[name] = event
+			log.Infof("Device %s: Component %s became unhealthy", ds.Device.ID, name)
+		} else if ok && raised {
+			delete(ds.healthEvents, name)
+			log.Infof("Device %s: Component %s became healthy", ds.Device.ID, name)
+		}
+	}
+	return healthy, children
+}
+
+// Returns the health status of the named component along with the status of the given subcomponents, if any; must
+// be called with the lock held
+func (ds *DeviceSimulator) componentStatus(name string, healthy map[string]bool, children map[string][]string) *healthz.ComponentStatus {
+	status := &healthz.ComponentStatus{Path: ComponentPath(name), Status: healthz.Status_STATUS_HEALTHLY}
+	if !healthy[name] {
+		status.Status = healthz.Status_STATUS_UNHEALTHY
+	}
+	if event, ok := ds.healthEvents[name]; ok {
+		status.Id = event.id
+		status.Acknowledged = event.acknowledged
+		status.Created = timestamppb.New(event.created)
+	}
+	for _, child := range children[name] {
+		status.Subcomponents = append(status.Subcomponents, ds.componentStatus(child, healthy, children))
+	}
+	return status
+}
+
+// ComponentPath returns the OpenConfig path of the named platform component
+func ComponentPath(name string) *types.Path {
+	return &types.Path{
+		Origin: "openconfig",
+		Elem: []*types.PathElem{
+			{Name: "components"},
+			{Name: "component", Key: map[string]string{"name": name}},
+		},
+	}
+}
+
+// ComponentName returns the name of the platform component with the given OpenConfig path
+func ComponentName(path *types.Path) (string, error) {
+	elems := path.GetElem()
+	if len(elems) != 2 || elems[0].Name != "components" || elems[1].Name != "component" || len(elems[1].Key["name"]) == 0 {
+		return "", errors.NewInvalid("path %v is not a component path", path)
+	}
+	return elems[1].Key["name"], nil
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnoi/healthz"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestComponentHealth(t *testing.T) {
+	simulation := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, simulation, topology)
+
+	status, err := ds.GetComponentHealth(config.ChassisName)
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_HEALTHLY, status.Status)
+	assert.NotEmpty(t, status.Subcomponents)
+	_, err = ds.GetComponentHealth("fan-99")
+	assert.True(t, errors.IsNotFound(err))
+
+	// Failed fan makes the chassis unhealthy and raises events for both at the time of the failure
+	assert.NoError(t, simulation.FailPlatformComponent(ds.Device.ID, "fan-1"))
+	ds.lock.RLock()
+	event, ok := ds.healthEvents["fan-1"]
+	ds.lock.RUnlock()
+	assert.True(t, ok)
+	failed := event.created
+	time.Sleep(10 * time.Millisecond)
+	status, err = ds.GetComponentHealth("fan-1")
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_UNHEALTHY, status.Status)
+	assert.NotEmpty(t, status.Id)
+	assert.Equal(t, failed.UnixNano(), status.Created.AsTime().UnixNano())
+	status, err = ds.GetComponentHealth(config.ChassisName)
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_UNHEALTHY, status.Status)
+
+	statuses, err := ds.ListComponentHealth(config.ChassisName, false)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+
+	status, err = ds.GetComponentHealth("fan-1")
+	assert.NoError(t, err)
+	_, err = ds.AcknowledgeComponentHealth("fan-1", "bogus")
+	assert.True(t, errors.IsNotFound(err))
+	acked, err := ds.AcknowledgeComponentHealth("fan-1", status.Id)
+	assert.NoError(t, err)
+	assert.True(t, acked.Acknowledged)
+	statuses, err = ds.ListComponentHealth(config.ChassisName, false)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 1)
+	statuses, err = ds.ListComponentHealth(config.ChassisName, true)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+
+	assert.NoError(t, simulation.RestorePlatformComponent(ds.Device.ID, "fan-1"))
+	status, err = ds.GetComponentHealth(config.ChassisName)
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_HEALTHLY, status.Status)
+	statuses, err = ds.ListComponentHealth(config.ChassisName, true)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 0)
+
+	// Port faults make the port and the linecard unhealthy
+	var port *simapi.Port
+	for _, p := range ds.physicalPorts {
+		port = p
+		break
+	}
+	faults, err := NewPortFaults(10, 0, 0, 0, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, ds.SetPortFaults(port.ID, faults))
+	status, err = ds.GetComponentHealth(config.LinecardName)
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_UNHEALTHY, status.Status)
+	status, err = ds.GetComponentHealth(config.PortComponentName(port.Name))
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_UNHEALTHY, status.Status)
+	assert.NoError(t, ds.SetPortFaults(port.ID, nil))
+	status, err = ds.GetComponentHealth(config.LinecardName)
+	assert.NoError(t, err)
+	assert.Equal(t, healthz.Status_STATUS_HEALTHLY, status.Status)
+}
@@ -7,9 +7,11 @@ package simulator
 import (
 	"github.com/google/gopacket/layers"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-net-lib/pkg/p4utils"
 	"github.com/onosproject/onos-net-lib/pkg/packet"
 	"math/rand"
+	"net"
 	"sync"
 	"time"
 )
@@ -17,32 +19,187 @@ import (
 // HostSimulator simulates a single host
 type HostSimulator struct {
 	Host       *simapi.Host
+	Behavior   *HostBehavior
 	simulation *Simulation
 
 	lock sync.RWMutex
 	done chan string
+	lacp *lacpActor
+
+	// Multicast groups joined by the host, keyed by their string form
+	groups map[string]net.IP
+}
+
+// HostProfile identifies the manner in which a simulated host emits traffic to announce its presence
+type HostProfile string
+
+const (
+	// DefaultProfile hosts periodically emit ARP requests at random intervals
+	DefaultProfile HostProfile = "default"
+	// SilentProfile hosts emit no traffic on their own
+	SilentProfile HostProfile = "silent"
+	// ChattyProfile hosts emit ARP requests at a fixed, typically short, interval
+	ChattyProfile HostProfile = "chatty"
+	// BurstyProfile hosts emit a burst of announcements on startup and then behave as default hosts
+	BurstyProfile HostProfile = "bursty"
+)
+
+const (
+	defaultChattyInterval = 5 * time.Second
+	defaultBurstSize      = 5
+	burstGap              = 100 * time.Millisecond
+)
+
+// HostBehavior describes dynamic aspects of a simulated host and how it manifests its presence on the network
+type HostBehavior struct {
+	Profile HostProfile
+	// Interval between emissions of chatty hosts
+	Interval time.Duration
+	// BurstSize is the number of startup announcements of bursty hosts
+	BurstSize int
+	// ARPTargets is a fixed list of IP addresses for which the host emits ARP requests instead of random hosts
+	ARPTargets []string
+	// IGMPVersion is the version of IGMP reports emitted for IPv4 multicast groups; 3 unless set to 2
+	IGMPVersion int
+	// LACP enables the LACP actor on hosts with multiple NICs, regardless of the profile
+	LACP bool
+	// LACPFastRate makes the LACP actor emit LACPDUs every second rather than every 30 seconds
+	LACPFastRate bool
+}
+
+// NewHostBehavior creates a new host behavior from the given profile name and its parameters; empty profile
+// name and zero parameters yield the default behavior
+func NewHostBehavior(profile string, interval string, burstSize int, arpTargets []string) (*HostBehavior, error) {
+	behavior := &HostBehavior{Profile: HostProfile(profile), BurstSize: burstSize, ARPTargets: arpTargets}
+	switch behavior.Profile {
+	case "":
+		behavior.Profile = DefaultProfile
+	case DefaultProfile, SilentProfile, ChattyProfile, BurstyProfile:
+	default:
+		return nil, errors.NewInvalid("unsupported host profile %s", profile)
+	}
+
+	if len(interval) > 0 {
+		d, err := time.ParseDuration(interval)
+		if err != nil || d <= 0 {
+			return nil, errors.NewInvalid("invalid host emission interval %s", interval)
+		}
+		behavior.Interval = d
+	} else if behavior.Profile == ChattyProfile {
+		behavior.Interval = defaultChattyInterval
+	}
+
+	if behavior.BurstSize == 0 && behavior.Profile == BurstyProfile {
+		behavior.BurstSize = defaultBurstSize
+	}
+
+	for _, ip := range arpTargets {
+		if net.ParseIP(ip).To4() == nil {
+			return nil, errors.NewInvalid("invalid ARP target %s", ip)
+		}
+	}
+	return behavior, nil
+}
+
+// SetIGMPVersion sets the version of IGMP reports emitted by the host; zero selects the default version
+func (b *HostBehavior) SetIGMPVersion(version int) error {
+	if version != 0 && version != 2 && version != 3 {
+		return errors.NewInvalid("unsupported IGMP version %d", version)
+	}
+	b.IGMPVersion = version
+	return nil
 }
 
 // NewHostSimulator initializes a new device simulator
-func NewHostSimulator(host *simapi.Host, simulation *Simulation) *HostSimulator {
+func NewHostSimulator(host *simapi.Host, behavior *HostBehavior, simulation *Simulation) *HostSimulator {
 	log.Infof("Host %s: Creating simulator", host.ID)
+	if behavior == nil {
+		behavior = &HostBehavior{Profile: DefaultProfile}
+	}
 	return &HostSimulator{
 		Host:       host,
+		Behavior:   behavior,
 		simulation: simulation,
-		done:       make(chan string),
 	}
 }
 
-// Start starts background host simulation activities, e.g. emitting ARP and DHCP packets
+// Start starts background host simulation activities, e.g. emitting ARP, NDP and DHCP packets, as
+// prescribed by the host behavior
 func (hs *HostSimulator) Start() {
 	hs.lock.Lock()
 	defer hs.lock.Unlock()
-	go hs.emitARPRequests()
+	if hs.done != nil {
+		return // already started
+	}
+	hs.done = make(chan string)
+
+	behavior := hs.Behavior
+	hs.lacp = nil
+	if behavior.LACP && len(hs.Host.Interfaces) > 1 {
+		hs.lacp = newLACPActor(hs, behavior.LACPFastRate)
+		go hs.lacp.run(hs.done)
+	}
+	if behavior.Profile == SilentProfile {
+		log.Infof("Host %s: Silent host; not emitting any traffic", hs.Host.ID)
+		return
+	}
+	if behavior.Profile == BurstyProfile {
+		go hs.emitStartupBurst(behavior.BurstSize, hs.done)
+	}
+	go hs.emitARPRequests(behavior, hs.done)
+	if hs.hasIPv6() {
+		go hs.emitNDPPackets(behavior, hs.done)
+	}
 }
 
 // Stop stops any background host simulation activities
 func (hs *HostSimulator) Stop() {
-	hs.done <- "stop"
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	if hs.done != nil {
+		close(hs.done)
+		hs.done = nil
+	}
+}
+
+// SetBehavior sets the new host behavior and restarts the background host simulation activities accordingly
+func (hs *HostSimulator) SetBehavior(behavior *HostBehavior) {
+	hs.Stop()
+	hs.lock.Lock()
+	hs.Behavior = behavior
+	hs.lock.Unlock()
+	hs.Start()
+}
+
+// Returns the delay until the next emission; fixed interval if the behavior prescribes one, random otherwise
+func nextDelay(behavior *HostBehavior, minDelay int, varDelay int) time.Duration {
+	if behavior.Interval > 0 {
+		return behavior.Interval
+	}
+	return time.Duration(minDelay+rand.Intn(varDelay)) * time.Second
+}
+
+// Emits the specified number of gratuitous ARPs and unsolicited neighbor advertisements from all host NICs
+func (hs *HostSimulator) emitStartupBurst(count int, done chan string) {
+	for i := 0; i < count; i++ {
+		for _, nic := range hs.Host.Interfaces {
+			if len(nic.IpAddress) > 0 {
+				if err := hs.EmitGratuitousARP(nic); err != nil {
+					log.Warnf("Host %s: Unable to emit gratuitous ARP: %v", hs.Host.ID, err)
+				}
+			}
+			if len(nic.Ipv6Address) > 0 {
+				if err := hs.EmitNeighborAdvertisement(nic, nil, nil); err != nil {
+					log.Warnf("Host %s: Unable to emit neighbor advertisement: %v", hs.Host.ID, err)
+				}
+			}
+		}
+		select {
+		case <-time.After(burstGap):
+		case <-done:
+			return
+		}
+	}
 }
 
 // SendARPRequest simulates emission of an ARP request as a packet-in on all the hosts' interfaces
@@ -62,20 +219,35 @@ func (hs *HostSimulator) SendARPResponse(another *simapi.Host) {
 const (
 	arpMinDelay = 30
 	arpVardelay = 30
+	ndpMinDelay = 30
+	ndpVarDelay = 30
 )
 
-// Periodically emit ARP requests for other hosts' IP addresses
-func (hs *HostSimulator) emitARPRequests() {
+// Periodically emit ARP requests for other hosts' IP addresses or for the fixed ARP targets
+func (hs *HostSimulator) emitARPRequests(behavior *HostBehavior, done chan string) {
 	for {
 		select {
-		case <-time.After(time.Duration(arpMinDelay+rand.Intn(arpVardelay)) * time.Second):
-			hs.emitRandomARPRequest()
-		case <-hs.done:
+		case <-time.After(nextDelay(behavior, arpMinDelay, arpVardelay)):
+			if len(behavior.ARPTargets) > 0 {
+				hs.emitTargetARPRequests(behavior.ARPTargets)
+			} else {
+				hs.emitRandomARPRequest()
+			}
+		case <-done:
 			return
 		}
 	}
 }
 
+// Emits ARP queries for all the given ARP targets from all the host NICs
+func (hs *HostSimulator) emitTargetARPRequests(targets []string) {
+	for _, nic := range hs.Host.Interfaces {
+		if err := hs.EmitARPRequests(nic, targets); err != nil {
+			log.Warnf("Host %s: Unable to emit ARP for %v: %v", hs.Host.ID, targets, err)
+		}
+	}
+}
+
 // Picks a random host (other than us) and emits an ARP query for it
 func (hs *HostSimulator) emitRandomARPRequest() {
 	if another := hs.simulation.GetRandomHostSimulator(hs); another != nil {
@@ -106,23 +278,199 @@ func (hs *HostSimulator) EmitARPRequests(nic *simapi.NetworkInterface, dstIPs []
 			log.Warnf("Host %s: Unable to serialize ARP request: %+v", hs.Host.ID, err)
 			continue
 		}
-		deviceSim, err := hs.simulation.GetDeviceSimulatorForPort(nic.ID)
-		if err != nil {
-			log.Warnf("Host %s: Unable to find device simulator: %+v", hs.Host.ID, err)
+		hs.emitPacketIn(nic, arp, arpPuntRule)
+	}
+	return nil
+}
+
+// EmitGratuitousARP triggers the specified host NIC to announce its IP address via a gratuitous ARP request
+func (hs *HostSimulator) EmitGratuitousARP(nic *simapi.NetworkInterface) error {
+	if len(nic.IpAddress) == 0 {
+		return errors.NewInvalid("nic %s has no IP address", nic.MacAddress)
+	}
+	ourIP := packet.IP(nic.IpAddress)
+	arp, err := packet.ARPRequestPacket(ourIP, packet.MAC(nic.MacAddress), ourIP)
+	if err != nil {
+		return err
+	}
+	hs.emitPacketIn(nic, arp, arpPuntRule)
+	return nil
+}
+
+// Periodically emit IPv6 neighbor solicitations for other hosts' IPv6 addresses, after first announcing
+// our own IPv6 addresses via unsolicited neighbor advertisements and router solicitations
+func (hs *HostSimulator) emitNDPPackets(behavior *HostBehavior, done chan string) {
+	announced := false
+	for {
+		select {
+		case <-time.After(nextDelay(behavior, ndpMinDelay, ndpVarDelay)):
+			if !announced {
+				hs.announceIPv6()
+				announced = true
+			} else {
+				hs.emitRandomNeighborSolicitation()
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// Emits unsolicited neighbor advertisement and router solicitation from all IPv6-enabled NICs
+func (hs *HostSimulator) announceIPv6() {
+	for _, nic := range hs.Host.Interfaces {
+		if len(nic.Ipv6Address) == 0 {
 			continue
 		}
-		if deviceSim.Ports[nic.ID].Enabled {
-			if roleAgentID, ok := deviceSim.HasPuntRuleForEthType(layers.EthernetTypeARP); ok {
-				deviceSim.SendPacketIn(arp, &p4utils.PacketInMetadata{
-					IngressPort: deviceSim.Ports[nic.ID].InternalNumber,
-					RoleAgentID: roleAgentID,
-				})
+		if err := hs.EmitNeighborAdvertisement(nic, nil, nil); err != nil {
+			log.Warnf("Host %s: Unable to emit neighbor advertisement: %v", hs.Host.ID, err)
+		}
+		if err := hs.EmitRouterSolicitation(nic); err != nil {
+			log.Warnf("Host %s: Unable to emit router solicitation: %v", hs.Host.ID, err)
+		}
+	}
+}
+
+// Picks a random host (other than us) and emits a neighbor solicitation for its IPv6 address, if it has one
+func (hs *HostSimulator) emitRandomNeighborSolicitation() {
+	if another := hs.simulation.GetRandomHostSimulator(hs); another != nil {
+		if anotherNIC := another.GetRandomNetworkInterface(); anotherNIC != nil && len(anotherNIC.Ipv6Address) > 0 {
+			for _, nic := range hs.Host.Interfaces {
+				if len(nic.Ipv6Address) > 0 {
+					if err := hs.EmitNeighborSolicitations(nic, []string{anotherNIC.Ipv6Address}); err != nil {
+						log.Warnf("Host %s: Unable to emit NS for %s: %v", hs.Host.ID, anotherNIC.Ipv6Address, err)
+					}
+				}
 			}
 		}
 	}
+}
+
+// EmitNeighborSolicitations triggers the specified host NIC to send IPv6 neighbor solicitations for a set of
+// IPv6 addresses
+func (hs *HostSimulator) EmitNeighborSolicitations(nic *simapi.NetworkInterface, dstIPs []string) error {
+	ourIP := IPv6(nic.Ipv6Address)
+	if ourIP == nil {
+		return errors.NewInvalid("nic %s has no IPv6 address", nic.MacAddress)
+	}
+	for _, ip := range dstIPs {
+		ns, err := NeighborSolicitationPacket(IPv6(ip), packet.MAC(nic.MacAddress), ourIP)
+		if err != nil {
+			log.Warnf("Host %s: Unable to serialize neighbor solicitation: %+v", hs.Host.ID, err)
+			continue
+		}
+		hs.emitPacketIn(nic, ns, icmpv6PuntRule)
+	}
+	return nil
+}
+
+// EmitNeighborAdvertisement triggers the specified host NIC to send an IPv6 neighbor advertisement for its address;
+// if the destination addresses are nil, the advertisement will be an unsolicited one
+func (hs *HostSimulator) EmitNeighborAdvertisement(nic *simapi.NetworkInterface, dstMAC net.HardwareAddr, dstIP net.IP) error {
+	ourIP := IPv6(nic.Ipv6Address)
+	if ourIP == nil {
+		return errors.NewInvalid("nic %s has no IPv6 address", nic.MacAddress)
+	}
+	na, err := NeighborAdvertisementPacket(packet.MAC(nic.MacAddress), ourIP, dstMAC, dstIP)
+	if err != nil {
+		return err
+	}
+	hs.emitPacketIn(nic, na, icmpv6PuntRule)
+	return nil
+}
+
+// EmitRouterSolicitation triggers the specified host NIC to send an IPv6 router solicitation
+func (hs *HostSimulator) EmitRouterSolicitation(nic *simapi.NetworkInterface) error {
+	ourIP := IPv6(nic.Ipv6Address)
+	if ourIP == nil {
+		return errors.NewInvalid("nic %s has no IPv6 address", nic.MacAddress)
+	}
+	rs, err := RouterSolicitationPacket(packet.MAC(nic.MacAddress), ourIP)
+	if err != nil {
+		return err
+	}
+	hs.emitPacketIn(nic, rs, icmpv6PuntRule)
 	return nil
 }
 
+// ProcessNeighborSolicitation answers the given neighbor solicitation received on the specified NIC with
+// a solicited neighbor advertisement, if the solicitation targets the NIC's IPv6 address
+func (hs *HostSimulator) ProcessNeighborSolicitation(nic *simapi.NetworkInterface, ns *layers.ICMPv6NeighborSolicitation,
+	srcMAC net.HardwareAddr, srcIP net.IP) {
+	if ourIP := IPv6(nic.Ipv6Address); ourIP == nil || !ourIP.Equal(ns.TargetAddress) {
+		return
+	}
+	if err := hs.EmitNeighborAdvertisement(nic, srcMAC, srcIP); err != nil {
+		log.Warnf("Host %s: Unable to answer neighbor solicitation: %v", hs.Host.ID, err)
+	}
+}
+
+// Returns true if any of the host NICs has an IPv6 address
+func (hs *HostSimulator) hasIPv6() bool {
+	for _, nic := range hs.Host.Interfaces {
+		if len(nic.Ipv6Address) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Function that determines whether the device has a rule to punt a packet and returns the role agent ID if so
+type puntRule func(deviceSim *DeviceSimulator) (uint32, bool)
+
+func arpPuntRule(deviceSim *DeviceSimulator) (uint32, bool) {
+	return deviceSim.HasPuntRuleForEthType(layers.EthernetTypeARP)
+}
+
+func icmpv6PuntRule(deviceSim *DeviceSimulator) (uint32, bool) {
+	return deviceSim.HasPuntRuleForIPProto(layers.EthernetTypeIPv6, layers.IPProtocolICMPv6)
+}
+
+// Emits the given packet as a packet-in via the device port to which the NIC is attached, provided that
+// the port is enabled and that the device has a rule to punt the packet to CPU
+func (hs *HostSimulator) emitPacketIn(nic *simapi.NetworkInterface, packet []byte, hasPuntRule puntRule) {
+	if !hs.IsNetworkInterfaceUp(nic) {
+		return
+	}
+	hs.sendPacketIn(nic, packet, hasPuntRule)
+}
+
+// Sends the packet-in via the device port to which the NIC is attached, regardless of the NIC LACP state
+func (hs *HostSimulator) sendPacketIn(nic *simapi.NetworkInterface, packet []byte, hasPuntRule puntRule) {
+	deviceSim, err := hs.simulation.GetDeviceSimulatorForPort(nic.ID)
+	if err != nil {
+		log.Warnf("Host %s: Unable to find device simulator: %+v", hs.Host.ID, err)
+		return
+	}
+	if port, ok := deviceSim.Ports[nic.ID]; ok && port.Enabled {
+		if roleAgentID, ok := hasPuntRule(deviceSim); ok {
+			deviceSim.SendPacketIn(packet, &p4utils.PacketInMetadata{
+				IngressPort: port.InternalNumber,
+				RoleAgentID: roleAgentID,
+			})
+		}
+	}
+}
+
+// ProcessLACPDU processes the LACPDU received from the partner on the specified NIC; ignored if the host
+// does not run the LACP actor
+func (hs *HostSimulator) ProcessLACPDU(nic *simapi.NetworkInterface, pdu *LACPDU) {
+	hs.lock.RLock()
+	actor := hs.lacp
+	hs.lock.RUnlock()
+	if actor != nil {
+		actor.processLACPDU(nic, pdu)
+	}
+}
+
+// IsNetworkInterfaceUp returns false if the specified NIC was brought down due to its LACP partner timing out
+func (hs *HostSimulator) IsNetworkInterfaceUp(nic *simapi.NetworkInterface) bool {
+	hs.lock.RLock()
+	actor := hs.lacp
+	hs.lock.RUnlock()
+	return actor == nil || actor.isUp(nic)
+}
+
 // GetNetworkInterfaceByMac returns the network interface associated with the specified MAC address on this host
 func (hs *HostSimulator) GetNetworkInterfaceByMac(mac string) *simapi.NetworkInterface {
 	for _, nic := range hs.Host.Interfaces {
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewHostBehavior(t *testing.T) {
+	b, err := NewHostBehavior("", "", 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultProfile, b.Profile)
+
+	b, err = NewHostBehavior("chatty", "", 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultChattyInterval, b.Interval)
+
+	b, err = NewHostBehavior("bursty", "2s", 0, []string{"10.1.1.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultBurstSize, b.BurstSize)
+	assert.Equal(t, 2*time.Second, b.Interval)
+
+	_, err = NewHostBehavior("noisy", "", 0, nil)
+	assert.Error(t, err)
+	_, err = NewHostBehavior("chatty", "soon", 0, nil)
+	assert.Error(t, err)
+	_, err = NewHostBehavior("default", "", 0, []string{"not-an-ip"})
+	assert.Error(t, err)
+}
+
+func TestHostBehaviorFromTopology(t *testing.T) {
+	topology := loadTestTopology(t)
+
+	behaviors := make(map[string]*HostBehavior)
+	for _, hd := range topology.Hosts {
+		if hd.Behavior != nil {
+			b, err := NewHostBehavior(hd.Behavior.Profile, hd.Behavior.Interval, hd.Behavior.BurstSize, hd.Behavior.ARPTargets)
+			assert.NoError(t, err)
+			behaviors[hd.ID] = b
+		}
+	}
+	assert.Len(t, behaviors, 2)
+	assert.Equal(t, SilentProfile, behaviors["h114"].Profile)
+	assert.Equal(t, ChattyProfile, behaviors["h124"].Profile)
+	assert.Equal(t, 10*time.Second, behaviors["h124"].Interval)
+	assert.Len(t, behaviors["h124"].ARPTargets, 2)
+
+	for _, hd := range topology.Hosts {
+		if hd.ID == "h124" {
+			assert.NoError(t, behaviors[hd.ID].SetIGMPVersion(hd.Behavior.IGMPVersion))
+			assert.Equal(t, 2, behaviors[hd.ID].IGMPVersion)
+			assert.Len(t, hd.Groups, 2)
+		}
+	}
+	assert.Error(t, behaviors["h114"].SetIGMPVersion(4))
+}
+
+func TestHostSetBehavior(t *testing.T) {
+	hs := NewHostSimulator(&simapi.Host{ID: "h1"}, nil, NewSimulation())
+	assert.Equal(t, DefaultProfile, hs.Behavior.Profile)
+	hs.Start()
+	hs.SetBehavior(&HostBehavior{Profile: SilentProfile})
+	assert.Equal(t, SilentProfile, hs.Behavior.Profile)
+	hs.Stop()
+	hs.Stop()
+}
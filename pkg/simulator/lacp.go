@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/packet"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	lacpSubtype    = 1
+	lacpVersion    = 1
+	lacpDULength   = 110
+	lacpInfoLength = 20
+
+	lacpTLVActor     = 1
+	lacpTLVPartner   = 2
+	lacpTLVCollector = 3
+
+	lacpStateActivity        = 0x01
+	lacpStateTimeout         = 0x02
+	lacpStateAggregation     = 0x04
+	lacpStateSynchronization = 0x08
+	lacpStateCollecting      = 0x10
+	lacpStateDistributing    = 0x20
+	lacpStateDefaulted       = 0x40
+	lacpStateExpired         = 0x80
+
+	lacpFastPeriod        = 1 * time.Second
+	lacpSlowPeriod        = 30 * time.Second
+	lacpTimeoutMultiplier = 3
+	lacpSystemPriority    = 0x8000
+	lacpPortPriority      = 0x8000
+	lacpKey               = 1
+)
+
+var (
+	ethernetTypeSlowProtocols = layers.EthernetType(0x8809)
+	slowProtocolsMAC          = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x02}
+)
+
+// LACPInfo carries the actor or partner information of an LACPDU
+type LACPInfo struct {
+	SystemPriority uint16
+	System         net.HardwareAddr
+	Key            uint16
+	PortPriority   uint16
+	Port           uint16
+	State          uint8
+}
+
+// LACPDU represents an LACP data unit
+type LACPDU struct {
+	Actor   LACPInfo
+	Partner LACPInfo
+}
+
+// LACPPacket returns packet bytes with the specified LACPDU sent from the given MAC address
+func LACPPacket(ourMAC net.HardwareAddr, pdu *LACPDU) ([]byte, error) {
+	eth := &layers.Ethernet{
+		SrcMAC:       ourMAC,
+		DstMAC:       slowProtocolsMAC,
+		EthernetType: ethernetTypeSlowProtocols,
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	err := gopacket.SerializeLayers(buf, opts, eth, gopacket.Payload(pdu.encode()))
+	return buf.Bytes(), err
+}
+
+// Encodes the LACPDU as the payload of a slow protocols frame
+func (pdu *LACPDU) encode() []byte {
+	b := make([]byte, lacpDULength)
+	b[0] = lacpSubtype
+	b[1] = lacpVersion
+	encodeLACPInfo(b[2:], lacpTLVActor, &pdu.Actor)
+	encodeLACPInfo(b[22:], lacpTLVPartner, &pdu.Partner)
+	b[42] = lacpTLVCollector
+	b[43] = 16
+	// Collector max delay, reserved bytes, the terminator TLV and its padding are all zeroes
+	return b
+}
+
+func encodeLACPInfo(b []byte, tlvType uint8, info *LACPInfo) {
+	b[0] = tlvType
+	b[1] = lacpInfoLength
+	binary.BigEndian.PutUint16(b[2:], info.SystemPriority)
+	copy(b[4:10], info.System)
+	binary.BigEndian.PutUint16(b[10:], info.Key)
+	binary.BigEndian.PutUint16(b[12:], info.PortPriority)
+	binary.BigEndian.PutUint16(b[14:], info.Port)
+	b[16] = info.State
+}
+
+// DecodeLACPDU decodes the LACPDU from the payload of a slow protocols frame
+func DecodeLACPDU(b []byte) (*LACPDU, error) {
+	if len(b) < 42 || b[0] != lacpSubtype {
+		return nil, errors.NewInvalid("not an LACPDU")
+	}
+	if b[2] != lacpTLVActor || b[3] != lacpInfoLength || b[22] != lacpTLVPartner || b[23] != lacpInfoLength {
+		return nil, errors.NewInvalid("malformed LACPDU")
+	}
+	return &LACPDU{Actor: decodeLACPInfo(b[2:]), Partner: decodeLACPInfo(b[22:])}, nil
+}
+
+func decodeLACPInfo(b []byte) LACPInfo {
+	return LACPInfo{
+		SystemPriority: binary.BigEndian.Uint16(b[2:]),
+		System:         net.HardwareAddr(append([]byte{}, b[4:10]...)),
+		Key:            binary.BigEndian.Uint16(b[10:]),
+		PortPriority:   binary.BigEndian.Uint16(b[12:]),
+		Port:           binary.BigEndian.Uint16(b[14:]),
+		State:          b[16],
+	}
+}
+
+// Tracks the LACP state of a single host NIC
+type lacpPort struct {
+	nic      *simapi.NetworkInterface
+	number   uint16
+	partner  *LACPInfo
+	lastSeen time.Time
+	expired  bool
+}
+
+// Returns true unless the partner of the port has timed out
+func (p *lacpPort) isUp() bool {
+	return !p.expired
+}
+
+// Simulates the LACP actor of a multi-homed host; one port per host NIC
+type lacpActor struct {
+	hs     *HostSimulator
+	system net.HardwareAddr
+	fast   bool
+	lock   sync.RWMutex
+	ports  map[string]*lacpPort
+}
+
+func newLACPActor(hs *HostSimulator, fast bool) *lacpActor {
+	actor := &lacpActor{
+		hs:     hs,
+		system: packet.MAC(hs.Host.Interfaces[0].MacAddress),
+		fast:   fast,
+		ports:  make(map[string]*lacpPort),
+	}
+	for i, nic := range hs.Host.Interfaces {
+		actor.ports[nic.MacAddress] = &lacpPort{nic: nic, number: uint16(i + 1)}
+	}
+	return actor
+}
+
+// Emits LACPDUs from all host NICs right away and then periodically; checks for partner timeouts at the fast rate
+func (a *lacpActor) run(done chan string) {
+	period := lacpSlowPeriod
+	if a.fast {
+		period = lacpFastPeriod
+	}
+	emit := time.NewTicker(period)
+	defer emit.Stop()
+	check := time.NewTicker(lacpFastPeriod)
+	defer check.Stop()
+
+	a.emitLACPDUs()
+	for {
+		select {
+		case <-emit.C:
+			a.emitLACPDUs()
+		case <-check.C:
+			for _, port := range a.checkTimeouts() {
+				a.emitLACPDU(port)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// Expires partners of any ports which have not received an LACPDU within the partner's timeout and returns
+// the expired ports
+func (a *lacpActor) checkTimeouts() []*lacpPort {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	expired := make([]*lacpPort, 0)
+	for _, port := range a.ports {
+		if port.partner != nil && time.Since(port.lastSeen) > partnerTimeout(port.partner) {
+			log.Warnf("Host %s: LACP partner of NIC %s timed out; bringing NIC down", a.hs.Host.ID, port.nic.MacAddress)
+			port.partner = nil
+			port.expired = true
+			expired = append(expired, port)
+		}
+	}
+	return expired
+}
+
+// Returns the timeout after which the partner is considered lost, based on its own advertised timeout
+func partnerTimeout(partner *LACPInfo) time.Duration {
+	if partner.State&lacpStateTimeout != 0 {
+		return lacpTimeoutMultiplier * lacpFastPeriod
+	}
+	return lacpTimeoutMultiplier * lacpSlowPeriod
+}
+
+// Emits LACPDU from each of the host NICs
+func (a *lacpActor) emitLACPDUs() {
+	a.lock.RLock()
+	pdus := make(map[*simapi.NetworkInterface]*LACPDU, len(a.ports))
+	for _, port := range a.ports {
+		pdus[port.nic] = a.lacpdu(port)
+	}
+	a.lock.RUnlock()
+
+	for nic, pdu := range pdus {
+		a.sendLACPDU(nic, pdu)
+	}
+}
+
+// Emits LACPDU from the NIC of the given port
+func (a *lacpActor) emitLACPDU(port *lacpPort) {
+	a.lock.RLock()
+	pdu := a.lacpdu(port)
+	a.lock.RUnlock()
+	a.sendLACPDU(port.nic, pdu)
+}
+
+func (a *lacpActor) sendLACPDU(nic *simapi.NetworkInterface, pdu *LACPDU) {
+	b, err := LACPPacket(packet.MAC(nic.MacAddress), pdu)
+	if err != nil {
+		log.Warnf("Host %s: Unable to serialize LACPDU: %+v", a.hs.Host.ID, err)
+		return
+	}
+	a.hs.sendPacketIn(nic, b, lacpPuntRule)
+}
+
+// Produces the LACPDU for the given port, reflecting the port's partner information
+func (a *lacpActor) lacpdu(port *lacpPort) *LACPDU {
+	state := uint8(lacpStateActivity | lacpStateAggregation)
+	if a.fast {
+		state |= lacpStateTimeout
+	}
+	pdu := &LACPDU{
+		Actor: LACPInfo{
+			SystemPriority: lacpSystemPriority,
+			System:         a.system,
+			Key:            lacpKey,
+			PortPriority:   lacpPortPriority,
+			Port:           port.number,
+		},
+		Partner: LACPInfo{System: make(net.HardwareAddr, 6)},
+	}
+	switch {
+	case port.partner != nil:
+		state |= lacpStateSynchronization | lacpStateCollecting | lacpStateDistributing
+		pdu.Partner = *port.partner
+	case port.expired:
+		state |= lacpStateExpired | lacpStateDefaulted
+	default:
+		state |= lacpStateDefaulted
+	}
+	pdu.Actor.State = state
+	return pdu
+}
+
+// Records the actor information of the given partner LACPDU received on the specified NIC and replies with
+// an LACPDU right away if the partner state changed
+func (a *lacpActor) processLACPDU(nic *simapi.NetworkInterface, pdu *LACPDU) {
+	a.lock.Lock()
+	port, ok := a.ports[nic.MacAddress]
+	if !ok {
+		a.lock.Unlock()
+		return
+	}
+	if port.expired {
+		log.Infof("Host %s: LACP partner of NIC %s is back; bringing NIC up", a.hs.Host.ID, nic.MacAddress)
+	}
+	changed := port.partner == nil || !port.partner.equal(&pdu.Actor)
+	partner := pdu.Actor
+	port.partner = &partner
+	port.lastSeen = time.Now()
+	port.expired = false
+	a.lock.Unlock()
+
+	if changed {
+		a.emitLACPDU(port)
+	}
+}
+
+// Returns true if the given LACP info is the same as this one
+func (i *LACPInfo) equal(other *LACPInfo) bool {
+	return i.SystemPriority == other.SystemPriority && bytes.Equal(i.System, other.System) && i.Key == other.Key &&
+		i.PortPriority == other.PortPriority && i.Port == other.Port && i.State == other.State
+}
+
+// Returns true unless the partner of the given NIC has timed out
+func (a *lacpActor) isUp(nic *simapi.NetworkInterface) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if port, ok := a.ports[nic.MacAddress]; ok {
+		return port.isUp()
+	}
+	return true
+}
+
+func lacpPuntRule(deviceSim *DeviceSimulator) (uint32, bool) {
+	return deviceSim.HasPuntRuleForEthType(ethernetTypeSlowProtocols)
+}
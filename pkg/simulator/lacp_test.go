@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-net-lib/pkg/packet"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLACPPacket(t *testing.T) {
+	pdu := &LACPDU{
+		Actor: LACPInfo{SystemPriority: 1, System: packet.MAC("00:ca:fe:01:01:01"), Key: 7, PortPriority: 2,
+			Port: 3, State: lacpStateActivity | lacpStateTimeout},
+		Partner: LACPInfo{System: packet.MAC("00:ca:fe:00:00:01"), Port: 224},
+	}
+	b, err := LACPPacket(packet.MAC("00:ca:fe:01:01:01"), pdu)
+	assert.NoError(t, err)
+
+	p := gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	eth := p.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	assert.Equal(t, ethernetTypeSlowProtocols, eth.EthernetType)
+	assert.Equal(t, "01:80:c2:00:00:02", eth.DstMAC.String())
+	assert.Len(t, eth.Payload, lacpDULength)
+
+	decoded, err := DecodeLACPDU(eth.Payload)
+	assert.NoError(t, err)
+	assert.Equal(t, *pdu, *decoded)
+
+	_, err = DecodeLACPDU([]byte{0x02, 0x01})
+	assert.Error(t, err)
+}
+
+func TestLACPActor(t *testing.T) {
+	host := &simapi.Host{ID: "h1", Interfaces: []*simapi.NetworkInterface{
+		{ID: "leaf1/3", MacAddress: "00:ca:fe:01:01:01"},
+		{ID: "leaf2/3", MacAddress: "00:ca:fe:01:01:02"},
+	}}
+	hs := NewHostSimulator(host, &HostBehavior{Profile: SilentProfile, LACP: true, LACPFastRate: true}, NewSimulation())
+	hs.Start()
+	defer hs.Stop()
+	assert.NotNil(t, hs.lacp)
+
+	nic := host.Interfaces[0]
+	assert.True(t, hs.IsNetworkInterfaceUp(nic))
+	assert.Equal(t, uint8(lacpStateDefaulted), hs.lacp.lacpdu(hs.lacp.ports[nic.MacAddress]).Actor.State&lacpStateDefaulted)
+
+	// Hear from the partner and make sure we reflect it
+	partner := LACPInfo{System: packet.MAC("00:ca:fe:00:00:01"), Port: 224, State: lacpStateActivity | lacpStateTimeout}
+	hs.ProcessLACPDU(nic, &LACPDU{Actor: partner})
+	pdu := hs.lacp.lacpdu(hs.lacp.ports[nic.MacAddress])
+	assert.Equal(t, partner, pdu.Partner)
+	assert.NotZero(t, pdu.Actor.State&lacpStateSynchronization)
+
+	// Let the partner time out and make sure the NIC goes down, but only that NIC
+	hs.lacp.ports[nic.MacAddress].lastSeen = time.Now().Add(-partnerTimeout(&partner) - time.Second)
+	assert.Len(t, hs.lacp.checkTimeouts(), 1)
+	assert.False(t, hs.IsNetworkInterfaceUp(nic))
+	assert.True(t, hs.IsNetworkInterfaceUp(host.Interfaces[1]))
+	assert.NotZero(t, hs.lacp.lacpdu(hs.lacp.ports[nic.MacAddress]).Actor.State&lacpStateExpired)
+
+	// Hear from the partner again and make sure the NIC is back up
+	hs.ProcessLACPDU(nic, &LACPDU{Actor: partner})
+	assert.True(t, hs.IsNetworkInterfaceUp(nic))
+
+	// Single-homed hosts do not run LACP
+	single := NewHostSimulator(&simapi.Host{ID: "h2", Interfaces: host.Interfaces[:1]}, &HostBehavior{LACP: true}, NewSimulation())
+	single.Start()
+	defer single.Stop()
+	assert.Nil(t, single.lacp)
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"sort"
+)
+
+// LAG describes a link aggregation group of ports on a single device
+type LAG struct {
+	Name    string
+	Members []simapi.PortID
+	// MinLinks is the minimum number of members which must be up for the LAG to be up
+	MinLinks int
+
+	up bool
+}
+
+// AddLAG adds a link aggregation group of the specified ports to the specified device
+func (s *Simulation) AddLAG(id simapi.DeviceID, name string, members []simapi.PortID, minLinks int) error {
+	deviceSim, err := s.GetDeviceSimulator(id)
+	if err != nil {
+		return err
+	}
+	return deviceSim.AddLAG(name, members, minLinks)
+}
+
+// RemoveLAG removes the named link aggregation group from the specified device
+func (s *Simulation) RemoveLAG(id simapi.DeviceID, name string) error {
+	deviceSim, err := s.GetDeviceSimulator(id)
+	if err != nil {
+		return err
+	}
+	return deviceSim.RemoveLAG(name)
+}
+
+// AddLAG adds a link aggregation group of the specified ports; the ports must not be members of another group
+// and the group name must not clash with any port name
+func (ds *DeviceSimulator) AddLAG(name string, members []simapi.PortID, minLinks int) error {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+
+	if len(name) == 0 || len(members) == 0 {
+		return errors.NewInvalid("LAG must have a name and at least one member")
+	}
+	if minLinks < 0 || minLinks > len(members) {
+		return errors.NewInvalid("invalid LAG %s minimum links %d", name, minLinks)
+	}
+	if _, ok := ds.lags[name]; ok {
+		return errors.NewAlreadyExists("LAG %s already exists", name)
+	}
+	memberNames := make([]string, 0, len(members))
+	for _, id := range members {
+		port, ok := ds.Ports[id]
+		if !ok {
+			return errors.NewNotFound("port %s not found", id)
+		}
+		if lag := ds.getPortLAG(id); lag != nil {
+			return errors.NewInvalid("port %s is already a member of LAG %s", id, lag.Name)
+		}
+		memberNames = append(memberNames, port.Name)
+	}
+	for _, port := range ds.Ports {
+		if port.Name == name {
+			return errors.NewInvalid("LAG name %s clashes with port name", name)
+		}
+	}
+
+	log.Infof("Device %s: Adding LAG %s with members %v", ds.Device.ID, name, members)
+	lag := &LAG{Name: name, Members: members, MinLinks: minLinks}
+	ds.lags[name] = lag
+	config.AddAggregateInterface(ds.config, name, memberNames, minLinks)
+	lag.up = ds.isLAGUp(lag)
+	ds.updatePortStatus(name, lag.up)
+	return nil
+}
+
+// RemoveLAG removes the named link aggregation group
+func (ds *DeviceSimulator) RemoveLAG(name string) error {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	lag, ok := ds.lags[name]
+	if !ok {
+		return errors.NewNotFound("LAG %s not found", name)
+	}
+	log.Infof("Device %s: Removing LAG %s", ds.Device.ID, name)
+	delete(ds.lags, name)
+	memberNames := make([]string, 0, len(lag.Members))
+	for _, id := range lag.Members {
+		memberNames = append(memberNames, ds.Ports[id].Name)
+	}
+	config.RemoveAggregateInterface(ds.config, name, memberNames)
+	return nil
+}
+
+// GetLAGs returns the link aggregation groups of the device, sorted by name
+func (ds *DeviceSimulator) GetLAGs() []*LAG {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	lags := make([]*LAG, 0, len(ds.lags))
+	for _, lag := range ds.lags {
+		lags = append(lags, lag)
+	}
+	sort.Slice(lags, func(i, j int) bool { return lags[i].Name < lags[j].Name })
+	return lags
+}
+
+// Returns the LAG of which the specified port is a member; nil if none
+func (ds *DeviceSimulator) getPortLAG(id simapi.PortID) *LAG {
+	for _, lag := range ds.lags {
+		for _, member := range lag.Members {
+			if member == id {
+				return lag
+			}
+		}
+	}
+	return nil
+}
+
+// Returns true if enough of the LAG members are enabled
+func (ds *DeviceSimulator) isLAGUp(lag *LAG) bool {
+	minLinks := lag.MinLinks
+	if minLinks == 0 {
+		minLinks = 1
+	}
+	up := 0
+	for _, id := range lag.Members {
+		if ds.Ports[id].Enabled {
+			up++
+		}
+	}
+	return up >= minLinks
+}
+
+// Updates the oper-status of the LAG of which the specified port is a member, if the status changed
+func (ds *DeviceSimulator) updateLAGStatus(id simapi.PortID) {
+	lag := ds.getPortLAG(id)
+	if lag == nil {
+		return
+	}
+	if up := ds.isLAGUp(lag); up != lag.up {
+		log.Infof("Device %s: LAG %s is %s", ds.Device.ID, lag.Name, config.GetStatusString(up))
+		lag.up = up
+		ds.updatePortStatus(lag.Name, up)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLAG(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, core, topology)
+
+	members := topo.ConstructLAGMembers(topology.Devices[0], topo.LAG{Members: []uint32{1, 2}})
+	assert.NoError(t, core.AddLAG(ds.Device.ID, "lag1", members, 2))
+	assert.Len(t, ds.GetLAGs(), 1)
+
+	status := func() string {
+		return ds.config.GetPath("interfaces/interface[name=lag1]/state/oper-status").Value().GetStringVal()
+	}
+	assert.Equal(t, "UP", status())
+	member := ds.config.GetPath("interfaces/interface[name=lag1]/aggregation/state/member").Value()
+	assert.Len(t, member.GetLeaflistVal().Element, 2)
+	assert.Equal(t, "1", member.GetLeaflistVal().Element[0].GetStringVal())
+	assert.Equal(t, "lag1", ds.config.GetPath("interfaces/interface[name=2]/ethernet/state/aggregate-id").Value().GetStringVal())
+
+	// Taking down a single member takes down the LAG, since both members are required
+	assert.NoError(t, ds.DisablePort(members[0], simapi.StopMode_CHAOTIC_STOP))
+	assert.Equal(t, "DOWN", status())
+	assert.NoError(t, ds.EnablePort(members[0]))
+	assert.Equal(t, "UP", status())
+
+	assert.Error(t, ds.AddLAG("lag1", members, 1))
+	assert.Error(t, ds.AddLAG("lag2", members[:1], 1))
+	assert.Error(t, ds.AddLAG("3", []simapi.PortID{"spine1/4"}, 1))
+	assert.Error(t, ds.AddLAG("lag2", []simapi.PortID{"spine1/4"}, 2))
+	assert.Error(t, ds.AddLAG("lag2", []simapi.PortID{"foo/1"}, 1))
+
+	assert.NoError(t, core.RemoveLAG(ds.Device.ID, "lag1"))
+	assert.Nil(t, ds.config.GetPath("interfaces/interface[name=lag1]"))
+	assert.Nil(t, ds.config.GetPath("interfaces/interface[name=2]/ethernet/state/aggregate-id"))
+	assert.Error(t, core.RemoveLAG(ds.Device.ID, "lag1"))
+}
@@ -6,12 +6,91 @@ package simulator
 
 import (
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"math/rand"
+	"sync"
+	"time"
 )
 
 // LinkSimulator simulates a single link
 type LinkSimulator struct {
 	Link *simapi.Link
-	// TODO: Add references to the link-specific processors
+
+	lock       sync.RWMutex
+	impairment *LinkImpairment
+	flaps      []LinkFlap
+	flapDone   chan string
+	disabled   bool
+
+	speedMismatch bool
+}
+
+// LinkDisableMode identifies the manner in which a link fails when disabled
+type LinkDisableMode int
+
+const (
+	// LinkDisableBidirectional mode takes down both directions of the link along with both of its end ports,
+	// as if the cable was cut
+	LinkDisableBidirectional LinkDisableMode = iota
+	// LinkDisableSilentUnidirectional mode stops only the given direction of the link from carrying packets,
+	// while both of its end ports stay up, as is the case with unidirectional fiber faults
+	LinkDisableSilentUnidirectional
+)
+
+// LinkImpairment describes how a simulated link delays, drops and duplicates the packets moving across it
+type LinkImpairment struct {
+	// Latency is the base delay of each packet
+	Latency time.Duration
+	// Jitter is the maximum random deviation from the base delay in either direction
+	Jitter time.Duration
+	// Loss is the probability, between 0 and 1, of a packet being dropped
+	Loss float64
+	// Duplication is the probability, between 0 and 1, of a packet being delivered twice
+	Duplication float64
+}
+
+// NewLinkImpairment creates a new link impairment from the given durations and probabilities; empty durations
+// and zero probabilities yield no impairment
+func NewLinkImpairment(latency string, jitter string, loss float64, duplication float64) (*LinkImpairment, error) {
+	impairment := &LinkImpairment{Loss: loss, Duplication: duplication}
+	var err error
+	if impairment.Latency, err = parseDuration(latency); err != nil {
+		return nil, errors.NewInvalid("invalid link latency %s", latency)
+	}
+	if impairment.Jitter, err = parseDuration(jitter); err != nil {
+		return nil, errors.NewInvalid("invalid link jitter %s", jitter)
+	}
+	if loss < 0 || loss > 1 {
+		return nil, errors.NewInvalid("invalid link loss probability %f", loss)
+	}
+	if duplication < 0 || duplication > 1 {
+		return nil, errors.NewInvalid("invalid link duplication probability %f", duplication)
+	}
+	return impairment, nil
+}
+
+// Parses the given non-negative duration; empty string yields zero duration
+func parseDuration(s string) (time.Duration, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err == nil && d < 0 {
+		err = errors.NewInvalid("negative duration")
+	}
+	return d, err
+}
+
+// Returns the delay of a packet, i.e. the latency randomly deviated by up to the jitter in either direction
+func (i *LinkImpairment) delay() time.Duration {
+	d := i.Latency
+	if i.Jitter > 0 {
+		d += time.Duration(rand.Int63n(2*int64(i.Jitter)+1)) - i.Jitter
+	}
+	if d < 0 {
+		return 0
+	}
+	return d
 }
 
 // NewLinkSimulator initializes a new device simulator
@@ -20,4 +99,68 @@ func NewLinkSimulator(link *simapi.Link) *LinkSimulator {
 	return &LinkSimulator{Link: link}
 }
 
-// TODO: Additional simulation logic goes here
+// SetImpairment sets the impairment of the link; nil removes any impairment
+func (ls *LinkSimulator) SetImpairment(impairment *LinkImpairment) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	log.Infof("Link %s: Setting impairment %+v", ls.Link.ID, impairment)
+	ls.impairment = impairment
+}
+
+// Impairment returns the impairment of the link; nil if the link is not impaired
+func (ls *LinkSimulator) Impairment() *LinkImpairment {
+	ls.lock.RLock()
+	defer ls.lock.RUnlock()
+	return ls.impairment
+}
+
+// IsDisabled returns true if the link has been disabled and does not carry any packets
+func (ls *LinkSimulator) IsDisabled() bool {
+	ls.lock.RLock()
+	defer ls.lock.RUnlock()
+	return ls.disabled
+}
+
+// Marks the link as disabled or enabled and updates its status accordingly
+func (ls *LinkSimulator) setDisabled(disabled bool) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	ls.disabled = disabled
+	if disabled {
+		ls.Link.Status = simapi.LinkStatus_LINK_DOWN
+	} else {
+		ls.Link.Status = simapi.LinkStatus_LINK_UP
+	}
+}
+
+// Transmit moves a packet across the link by invoking the given deliver function, subject to the link
+// impairment; the packet may be dropped, delivered after a delay or delivered twice; disabled link drops
+// all packets
+func (ls *LinkSimulator) Transmit(deliver func()) {
+	if ls.IsDisabled() {
+		log.Debugf("Link %s: Dropped packet on disabled link", ls.Link.ID)
+		return
+	}
+	impairment := ls.Impairment()
+	if impairment == nil {
+		deliver()
+		return
+	}
+
+	if impairment.Loss > 0 && rand.Float64() < impairment.Loss {
+		log.Debugf("Link %s: Dropped packet", ls.Link.ID)
+		return
+	}
+	copies := 1
+	if impairment.Duplication > 0 && rand.Float64() < impairment.Duplication {
+		log.Debugf("Link %s: Duplicated packet", ls.Link.ID)
+		copies++
+	}
+	for i := 0; i < copies; i++ {
+		if d := impairment.delay(); d > 0 {
+			time.AfterFunc(d, deliver)
+		} else {
+			deliver()
+		}
+	}
+}
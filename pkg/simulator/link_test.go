@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewLinkImpairment(t *testing.T) {
+	i, err := NewLinkImpairment("10ms", "2ms", 0.1, 0.01)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Millisecond, i.Latency)
+	assert.Equal(t, 2*time.Millisecond, i.Jitter)
+	for n := 0; n < 100; n++ {
+		d := i.delay()
+		assert.True(t, d >= 8*time.Millisecond && d <= 12*time.Millisecond)
+	}
+
+	i, err = NewLinkImpairment("", "", 0, 0)
+	assert.NoError(t, err)
+	assert.Zero(t, i.delay())
+
+	_, err = NewLinkImpairment("soon", "", 0, 0)
+	assert.Error(t, err)
+	_, err = NewLinkImpairment("", "-1ms", 0, 0)
+	assert.Error(t, err)
+	_, err = NewLinkImpairment("", "", 1.5, 0)
+	assert.Error(t, err)
+	_, err = NewLinkImpairment("", "", 0, -0.5)
+	assert.Error(t, err)
+}
+
+func TestLinkImpairmentFromTopology(t *testing.T) {
+	topology := loadTestTopology(t)
+	li := topology.Links[0].Impairment
+	assert.NotNil(t, li)
+	i, err := NewLinkImpairment(li.Latency, li.Jitter, li.Loss, li.Duplication)
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Millisecond, i.Latency)
+	assert.Equal(t, 0.01, i.Loss)
+}
+
+func TestLinkTransmit(t *testing.T) {
+	ls := NewLinkSimulator(&simapi.Link{ID: "foo"})
+	var delivered int32
+	deliver := func() { atomic.AddInt32(&delivered, 1) }
+
+	// Unimpaired link delivers synchronously
+	ls.Transmit(deliver)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&delivered))
+
+	// Fully lossy link delivers nothing
+	ls.SetImpairment(&LinkImpairment{Loss: 1})
+	ls.Transmit(deliver)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&delivered))
+
+	// Fully duplicating link delivers twice
+	ls.SetImpairment(&LinkImpairment{Duplication: 1})
+	ls.Transmit(deliver)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&delivered))
+
+	// Link with latency delivers later
+	ls.SetImpairment(&LinkImpairment{Latency: 50 * time.Millisecond})
+	ls.Transmit(deliver)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&delivered))
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&delivered) == 4 }, time.Second, 10*time.Millisecond)
+
+	ls.SetImpairment(nil)
+	assert.Nil(t, ls.Impairment())
+}
+
+func TestDisableLink(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	forward, err := core.AddLinkSimulator(topo.ConstructLink(topology.Links[0]))
+	assert.NoError(t, err)
+	reverse, err := core.AddLinkSimulator(topo.ConstructReverseLink(topology.Links[0]))
+	assert.NoError(t, err)
+
+	srcDevice, _ := core.GetDeviceSimulatorForPort(forward.Link.SrcID)
+	tgtDevice, _ := core.GetDeviceSimulatorForPort(forward.Link.TgtID)
+	portsEnabled := func() bool {
+		return srcDevice.Ports[forward.Link.SrcID].Enabled && tgtDevice.Ports[forward.Link.TgtID].Enabled
+	}
+
+	delivered := 0
+	deliver := func() { delivered++ }
+
+	// Silent unidirectional failure drops packets only in one direction and keeps the ports up
+	assert.NoError(t, core.DisableLink(forward.Link.ID, LinkDisableSilentUnidirectional))
+	forward.Transmit(deliver)
+	reverse.Transmit(deliver)
+	assert.Equal(t, 1, delivered)
+	assert.True(t, portsEnabled())
+	assert.Equal(t, simapi.LinkStatus_LINK_DOWN, forward.Link.Status)
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, reverse.Link.Status)
+
+	assert.NoError(t, core.EnableLink(forward.Link.ID))
+	forward.Transmit(deliver)
+	assert.Equal(t, 2, delivered)
+
+	// Bidirectional failure drops packets in both directions and takes the ports down
+	assert.NoError(t, core.DisableLink(reverse.Link.ID, LinkDisableBidirectional))
+	forward.Transmit(deliver)
+	reverse.Transmit(deliver)
+	assert.Equal(t, 2, delivered)
+	assert.False(t, portsEnabled())
+	assert.True(t, forward.IsDisabled())
+
+	assert.NoError(t, core.EnableLink(reverse.Link.ID))
+	forward.Transmit(deliver)
+	reverse.Transmit(deliver)
+	assert.Equal(t, 4, delivered)
+	assert.True(t, portsEnabled())
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, forward.Link.Status)
+
+	assert.Error(t, core.DisableLink("foo", LinkDisableBidirectional))
+	assert.Error(t, core.EnableLink("foo"))
+}
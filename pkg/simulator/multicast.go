@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/binary"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/packet"
+	"net"
+	"sort"
+)
+
+const (
+	igmpv3RecordModeIsExclude     = 2
+	igmpv3RecordChangeToInclude   = 3
+	igmpv3RecordChangeToExclude   = 4
+	ipv4OptionRouterAlert         = 0x94
+	ipv6HopByHopOptionRouterAlert = 0x05
+	ipv6HopByHopOptionPadN        = 0x01
+)
+
+var (
+	allRoutersIPv4  = net.IPv4(224, 0, 0, 2).To4()
+	igmpv3ReportsIP = net.IPv4(224, 0, 0, 22).To4()
+	mldv2ReportsIP  = net.ParseIP("ff02::16")
+)
+
+// IGMPv2ReportPacket returns packet bytes with an IGMPv2 membership report, or leave group message if leave
+// is true, for the specified IPv4 multicast group
+func IGMPv2ReportPacket(ourMAC net.HardwareAddr, ourIP net.IP, group net.IP, leave bool) ([]byte, error) {
+	group = group.To4()
+	if group == nil || !group.IsMulticast() {
+		return nil, errors.NewInvalid("IGMP report requires IPv4 multicast group")
+	}
+	igmpType, dstIP := layers.IGMPMembershipReportV2, group
+	if leave {
+		igmpType, dstIP = layers.IGMPLeaveGroup, allRoutersIPv4
+	}
+	igmp := make([]byte, 8)
+	igmp[0] = byte(igmpType)
+	copy(igmp[4:], group)
+	return igmpPacket(ourMAC, ourIP, dstIP, igmp)
+}
+
+// IGMPv3ReportPacket returns packet bytes with an IGMPv3 membership report carrying a record of the given type
+// for each of the specified IPv4 multicast groups
+func IGMPv3ReportPacket(ourMAC net.HardwareAddr, ourIP net.IP, groups []net.IP, recordType uint8) ([]byte, error) {
+	igmp := make([]byte, 8, 8+8*len(groups))
+	igmp[0] = byte(layers.IGMPMembershipReportV3)
+	binary.BigEndian.PutUint16(igmp[6:], uint16(len(groups)))
+	for _, group := range groups {
+		group = group.To4()
+		if group == nil || !group.IsMulticast() {
+			return nil, errors.NewInvalid("IGMP report requires IPv4 multicast groups")
+		}
+		// Record type, aux data length and number of sources (none) followed by the group address
+		igmp = append(igmp, recordType, 0, 0, 0)
+		igmp = append(igmp, group...)
+	}
+	return igmpPacket(ourMAC, ourIP, igmpv3ReportsIP, igmp)
+}
+
+// MLDv2ReportPacket returns packet bytes with an MLDv2 multicast listener report carrying a record of the given
+// type for each of the specified IPv6 multicast groups
+func MLDv2ReportPacket(ourMAC net.HardwareAddr, ourIP net.IP, groups []net.IP,
+	recordType layers.MLDv2MulticastAddressRecordType) ([]byte, error) {
+	if len(ourIP) != net.IPv6len {
+		return nil, errors.NewInvalid("MLD report requires IPv6 address")
+	}
+	report := &layers.MLDv2MulticastListenerReportMessage{}
+	for _, group := range groups {
+		if group.To4() != nil || !group.IsMulticast() {
+			return nil, errors.NewInvalid("MLD report requires IPv6 multicast groups")
+		}
+		report.MulticastAddressRecords = append(report.MulticastAddressRecords,
+			layers.MLDv2MulticastAddressRecord{RecordType: recordType, MulticastAddress: group})
+	}
+
+	eth := &layers.Ethernet{
+		SrcMAC:       ourMAC,
+		DstMAC:       multicastMAC(mldv2ReportsIP),
+		EthernetType: layers.EthernetTypeIPv6,
+	}
+	ip6 := &layers.IPv6{
+		Version:    6,
+		NextHeader: layers.IPProtocolIPv6HopByHop,
+		HopLimit:   1,
+		SrcIP:      ourIP,
+		DstIP:      mldv2ReportsIP,
+		HopByHop: &layers.IPv6HopByHop{
+			Options: []*layers.IPv6HopByHopOption{
+				{OptionType: ipv6HopByHopOptionRouterAlert, OptionLength: 2, OptionData: []byte{0, 0}},
+				{OptionType: ipv6HopByHopOptionPadN},
+			},
+		},
+	}
+	ip6.HopByHop.NextHeader = layers.IPProtocolICMPv6
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeMLDv2MulticastListenerReportMessageV2, 0)}
+	if err := icmp.SetNetworkLayerForChecksum(ip6); err != nil {
+		return nil, err
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	err := gopacket.SerializeLayers(buf, opts, eth, ip6, icmp, report)
+	return buf.Bytes(), err
+}
+
+// Serializes the given IGMP message, sans checksum, into an Ethernet frame with IPv4 header carrying router alert
+func igmpPacket(ourMAC net.HardwareAddr, ourIP net.IP, dstIP net.IP, igmp []byte) ([]byte, error) {
+	binary.BigEndian.PutUint16(igmp[2:], checksum(igmp))
+	eth := &layers.Ethernet{
+		SrcMAC:       ourMAC,
+		DstMAC:       multicastMAC(dstIP),
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip4 := &layers.IPv4{
+		Version:  4,
+		TTL:      1,
+		Protocol: layers.IPProtocolIGMP,
+		SrcIP:    ourIP.To4(),
+		DstIP:    dstIP,
+		Options:  []layers.IPv4Option{{OptionType: ipv4OptionRouterAlert, OptionLength: 4, OptionData: []byte{0, 0}}},
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	err := gopacket.SerializeLayers(buf, opts, eth, ip4, gopacket.Payload(igmp))
+	return buf.Bytes(), err
+}
+
+// Returns the Internet checksum of the given bytes
+func checksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
+
+// Returns the Ethernet multicast MAC address corresponding to the given IPv4 or IPv6 multicast address
+func multicastMAC(ip net.IP) net.HardwareAddr {
+	if ip4 := ip.To4(); ip4 != nil {
+		return net.HardwareAddr{0x01, 0x00, 0x5e, ip4[1] & 0x7f, ip4[2], ip4[3]}
+	}
+	return net.HardwareAddr{0x33, 0x33, ip[12], ip[13], ip[14], ip[15]}
+}
+
+// JoinGroup has the host join the specified IPv4 or IPv6 multicast group and emit the corresponding IGMP or MLD
+// membership report from all its NICs of the matching address family
+func (hs *HostSimulator) JoinGroup(group string) error {
+	ip, err := multicastGroup(group)
+	if err != nil {
+		return err
+	}
+	hs.lock.Lock()
+	if hs.groups == nil {
+		hs.groups = make(map[string]net.IP)
+	}
+	hs.groups[ip.String()] = ip
+	hs.lock.Unlock()
+
+	log.Infof("Host %s: Joined multicast group %s", hs.Host.ID, ip)
+	hs.emitMembershipReports([]net.IP{ip}, false)
+	return nil
+}
+
+// LeaveGroup has the host leave the specified IPv4 or IPv6 multicast group and emit the corresponding IGMP or MLD
+// leave message from all its NICs of the matching address family
+func (hs *HostSimulator) LeaveGroup(group string) error {
+	ip, err := multicastGroup(group)
+	if err != nil {
+		return err
+	}
+	hs.lock.Lock()
+	if _, ok := hs.groups[ip.String()]; !ok {
+		hs.lock.Unlock()
+		return errors.NewNotFound("host %s is not a member of group %s", hs.Host.ID, ip)
+	}
+	delete(hs.groups, ip.String())
+	hs.lock.Unlock()
+
+	log.Infof("Host %s: Left multicast group %s", hs.Host.ID, ip)
+	hs.emitMembershipReports([]net.IP{ip}, true)
+	return nil
+}
+
+// Groups returns the sorted list of multicast groups of which the host is a member
+func (hs *HostSimulator) Groups() []string {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+	groups := make([]string, 0, len(hs.groups))
+	for group := range hs.groups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// ProcessMembershipQuery answers the IGMP or MLD membership query received on the specified NIC with reports for
+// all matching groups of which the host is a member; unspecified group indicates a general query
+func (hs *HostSimulator) ProcessMembershipQuery(nic *simapi.NetworkInterface, group net.IP, ipv6 bool) {
+	hs.lock.RLock()
+	groups := make([]net.IP, 0, len(hs.groups))
+	for _, ip := range hs.groups {
+		if (ip.To4() == nil) == ipv6 && (group.IsUnspecified() || group.Equal(ip)) {
+			groups = append(groups, ip)
+		}
+	}
+	hs.lock.RUnlock()
+
+	if len(groups) > 0 {
+		hs.emitNICMembershipReports(nic, groups, false, true)
+	}
+}
+
+// Parses the given group address and validates that it is a multicast one
+func multicastGroup(group string) (net.IP, error) {
+	ip := net.ParseIP(group)
+	if ip == nil || !ip.IsMulticast() {
+		return nil, errors.NewInvalid("invalid multicast group %s", group)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4, nil
+	}
+	return ip, nil
+}
+
+// Emits membership reports, or leave messages, for the given groups from all host NICs
+func (hs *HostSimulator) emitMembershipReports(groups []net.IP, leave bool) {
+	for _, nic := range hs.Host.Interfaces {
+		hs.emitNICMembershipReports(nic, groups, leave, false)
+	}
+}
+
+// Emits membership reports, or leave messages, for the given groups from the specified NIC; the reports for
+// IPv4 groups are IGMPv2 or IGMPv3, as prescribed by the host behavior, and the reports for IPv6 groups are MLDv2
+func (hs *HostSimulator) emitNICMembershipReports(nic *simapi.NetworkInterface, groups []net.IP, leave bool, current bool) {
+	hs.lock.RLock()
+	version := hs.Behavior.IGMPVersion
+	hs.lock.RUnlock()
+
+	ip4Groups := make([]net.IP, 0, len(groups))
+	ip6Groups := make([]net.IP, 0, len(groups))
+	for _, group := range groups {
+		if group.To4() != nil {
+			ip4Groups = append(ip4Groups, group)
+		} else {
+			ip6Groups = append(ip6Groups, group)
+		}
+	}
+
+	mac := packet.MAC(nic.MacAddress)
+	if len(ip4Groups) > 0 && len(nic.IpAddress) > 0 {
+		ourIP := packet.IP(nic.IpAddress)
+		if version == 2 {
+			for _, group := range ip4Groups {
+				b, err := IGMPv2ReportPacket(mac, ourIP, group, leave)
+				hs.emitMembershipReport(nic, "IGMPv2", b, err, igmpPuntRule)
+			}
+		} else {
+			recordType := uint8(igmpv3RecordChangeToExclude)
+			if leave {
+				recordType = igmpv3RecordChangeToInclude
+			} else if current {
+				recordType = igmpv3RecordModeIsExclude
+			}
+			b, err := IGMPv3ReportPacket(mac, ourIP, ip4Groups, recordType)
+			hs.emitMembershipReport(nic, "IGMPv3", b, err, igmpPuntRule)
+		}
+	}
+
+	if len(ip6Groups) > 0 && len(nic.Ipv6Address) > 0 {
+		recordType := layers.MLDv2MulticastAddressRecordTypeChangeToExcludeMode
+		if leave {
+			recordType = layers.MLDv2MulticastAddressRecordTypeChangeToIncludeMode
+		} else if current {
+			recordType = layers.MLDv2MulticastAddressRecordTypeModeIsExcluded
+		}
+		b, err := MLDv2ReportPacket(mac, IPv6(nic.Ipv6Address), ip6Groups, recordType)
+		hs.emitMembershipReport(nic, "MLDv2", b, err, icmpv6PuntRule)
+	}
+}
+
+// Emits the serialized membership report as a packet-in via the given NIC, unless its serialization failed
+func (hs *HostSimulator) emitMembershipReport(nic *simapi.NetworkInterface, kind string, b []byte, err error, hasPuntRule puntRule) {
+	if err != nil {
+		log.Warnf("Host %s: Unable to serialize %s report: %+v", hs.Host.ID, kind, err)
+		return
+	}
+	hs.emitPacketIn(nic, b, hasPuntRule)
+}
+
+func igmpPuntRule(deviceSim *DeviceSimulator) (uint32, bool) {
+	return deviceSim.HasPuntRuleForIPProto(layers.EthernetTypeIPv4, layers.IPProtocolIGMP)
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-net-lib/pkg/packet"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func TestIGMPReportPackets(t *testing.T) {
+	mac := packet.MAC("00:ca:fe:01:01:01")
+	ip := packet.IP("10.1.1.1")
+	group := net.ParseIP("239.1.2.3")
+
+	b, err := IGMPv2ReportPacket(mac, ip, group, false)
+	assert.NoError(t, err)
+	p := gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	assert.Equal(t, "01:00:5e:01:02:03", p.Layer(layers.LayerTypeEthernet).(*layers.Ethernet).DstMAC.String())
+	ip4 := p.Layer(layers.LayerTypeIPv4).(*layers.IPv4)
+	assert.Equal(t, uint8(1), ip4.TTL)
+	assert.Len(t, ip4.Options, 1)
+	igmp2 := p.Layer(layers.LayerTypeIGMP).(*layers.IGMPv1or2)
+	assert.Equal(t, layers.IGMPMembershipReportV2, igmp2.Type)
+	assert.Equal(t, "239.1.2.3", igmp2.GroupAddress.String())
+	assert.Zero(t, checksum(ip4.Payload))
+
+	b, err = IGMPv2ReportPacket(mac, ip, group, true)
+	assert.NoError(t, err)
+	p = gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	assert.Equal(t, "224.0.0.2", p.Layer(layers.LayerTypeIPv4).(*layers.IPv4).DstIP.String())
+	assert.Equal(t, layers.IGMPLeaveGroup, p.Layer(layers.LayerTypeIGMP).(*layers.IGMPv1or2).Type)
+
+	b, err = IGMPv3ReportPacket(mac, ip, []net.IP{group, net.ParseIP("239.1.2.4")}, igmpv3RecordChangeToExclude)
+	assert.NoError(t, err)
+	p = gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	assert.Equal(t, "224.0.0.22", p.Layer(layers.LayerTypeIPv4).(*layers.IPv4).DstIP.String())
+	igmp3 := p.Layer(layers.LayerTypeIGMP).(*layers.IGMP)
+	assert.Equal(t, layers.IGMPMembershipReportV3, igmp3.Type)
+	assert.Len(t, igmp3.GroupRecords, 2)
+	assert.Equal(t, layers.IGMPv3GroupRecordType(igmpv3RecordChangeToExclude), igmp3.GroupRecords[0].Type)
+	assert.Equal(t, "239.1.2.4", igmp3.GroupRecords[1].MulticastAddress.String())
+
+	_, err = IGMPv2ReportPacket(mac, ip, net.ParseIP("10.1.1.2"), false)
+	assert.Error(t, err)
+	_, err = IGMPv3ReportPacket(mac, ip, []net.IP{net.ParseIP("ff05::1")}, igmpv3RecordModeIsExclude)
+	assert.Error(t, err)
+}
+
+func TestMLDv2ReportPacket(t *testing.T) {
+	b, err := MLDv2ReportPacket(packet.MAC("00:ca:fe:01:01:01"), IPv6("2001:db8::1"), []net.IP{net.ParseIP("ff05::1:3")},
+		layers.MLDv2MulticastAddressRecordTypeChangeToExcludeMode)
+	assert.NoError(t, err)
+	p := gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	assert.Equal(t, "33:33:00:00:00:16", p.Layer(layers.LayerTypeEthernet).(*layers.Ethernet).DstMAC.String())
+	assert.NotNil(t, p.Layer(layers.LayerTypeIPv6HopByHop))
+	report := p.Layer(layers.LayerTypeMLDv2MulticastListenerReport).(*layers.MLDv2MulticastListenerReportMessage)
+	assert.Len(t, report.MulticastAddressRecords, 1)
+	assert.Equal(t, "ff05::1:3", report.MulticastAddressRecords[0].MulticastAddress.String())
+
+	_, err = MLDv2ReportPacket(packet.MAC("00:ca:fe:01:01:01"), IPv6("2001:db8::1"), []net.IP{net.ParseIP("239.1.1.1")},
+		layers.MLDv2MulticastAddressRecordTypeModeIsExcluded)
+	assert.Error(t, err)
+}
+
+func TestHostMulticastGroups(t *testing.T) {
+	hs := NewHostSimulator(&simapi.Host{ID: "h1", Interfaces: []*simapi.NetworkInterface{
+		{ID: "leaf1/3", MacAddress: "00:ca:fe:01:01:01", IpAddress: "10.1.1.1", Ipv6Address: "2001:db8::1"},
+	}}, nil, NewSimulation())
+
+	assert.NoError(t, hs.JoinGroup("239.1.1.1"))
+	assert.NoError(t, hs.JoinGroup("ff05::1:3"))
+	assert.NoError(t, hs.JoinGroup("239.1.1.1"))
+	assert.Equal(t, []string{"239.1.1.1", "ff05::1:3"}, hs.Groups())
+
+	// Answering queries should not fail even if the NIC port does not exist
+	hs.ProcessMembershipQuery(hs.Host.Interfaces[0], net.IPv4zero, false)
+	hs.ProcessMembershipQuery(hs.Host.Interfaces[0], net.IPv6unspecified, true)
+
+	assert.Error(t, hs.JoinGroup("10.1.1.2"))
+	assert.Error(t, hs.LeaveGroup("239.1.1.2"))
+	assert.NoError(t, hs.LeaveGroup("239.1.1.1"))
+	assert.Equal(t, []string{"ff05::1:3"}, hs.Groups())
+}
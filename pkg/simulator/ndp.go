@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"net"
+)
+
+const (
+	ndpFlagSolicited = 0x40
+	ndpFlagOverride  = 0x20
+)
+
+var (
+	allNodesIP    = net.ParseIP("ff02::1")
+	allRoutersIP  = net.ParseIP("ff02::2")
+	allNodesMAC   = net.HardwareAddr{0x33, 0x33, 0x00, 0x00, 0x00, 0x01}
+	allRoutersMAC = net.HardwareAddr{0x33, 0x33, 0x00, 0x00, 0x00, 0x02}
+)
+
+// IPv6 returns the given IPv6 address in its 16-byte form; nil if the address is not valid
+func IPv6(addr string) net.IP {
+	return net.ParseIP(addr).To16()
+}
+
+// Returns the solicited-node multicast IPv6 address and its corresponding multicast MAC address for the given IP
+func solicitedNodeAddresses(ip net.IP) (net.IP, net.HardwareAddr) {
+	snIP := net.IP{0xff, 0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0xff, ip[13], ip[14], ip[15]}
+	snMAC := net.HardwareAddr{0x33, 0x33, 0xff, ip[13], ip[14], ip[15]}
+	return snIP, snMAC
+}
+
+// NeighborSolicitationPacket returns packet bytes with an IPv6 neighbor solicitation for the specified target address
+func NeighborSolicitationPacket(theirIP net.IP, ourMAC net.HardwareAddr, ourIP net.IP) ([]byte, error) {
+	if len(theirIP) != net.IPv6len || len(ourIP) != net.IPv6len {
+		return nil, errors.NewInvalid("neighbor solicitation requires IPv6 addresses")
+	}
+	dstIP, dstMAC := solicitedNodeAddresses(theirIP)
+	ns := &layers.ICMPv6NeighborSolicitation{
+		TargetAddress: theirIP,
+		Options:       layers.ICMPv6Options{{Type: layers.ICMPv6OptSourceAddress, Data: ourMAC}},
+	}
+	return icmpv6Packet(ourMAC, dstMAC, ourIP, dstIP, layers.ICMPv6TypeNeighborSolicitation, ns)
+}
+
+// NeighborAdvertisementPacket returns packet bytes with an IPv6 neighbor advertisement for our address; if the
+// destination addresses are nil, the advertisement is an unsolicited one sent to all nodes
+func NeighborAdvertisementPacket(ourMAC net.HardwareAddr, ourIP net.IP, theirMAC net.HardwareAddr, theirIP net.IP) ([]byte, error) {
+	if len(ourIP) != net.IPv6len {
+		return nil, errors.NewInvalid("neighbor advertisement requires IPv6 address")
+	}
+	flags := uint8(ndpFlagOverride)
+	if theirIP == nil || theirIP.IsUnspecified() {
+		theirIP, theirMAC = allNodesIP, allNodesMAC
+	} else {
+		flags |= ndpFlagSolicited
+	}
+	na := &layers.ICMPv6NeighborAdvertisement{
+		Flags:         flags,
+		TargetAddress: ourIP,
+		Options:       layers.ICMPv6Options{{Type: layers.ICMPv6OptTargetAddress, Data: ourMAC}},
+	}
+	return icmpv6Packet(ourMAC, theirMAC, ourIP, theirIP, layers.ICMPv6TypeNeighborAdvertisement, na)
+}
+
+// RouterSolicitationPacket returns packet bytes with an IPv6 router solicitation sent to all routers
+func RouterSolicitationPacket(ourMAC net.HardwareAddr, ourIP net.IP) ([]byte, error) {
+	if len(ourIP) != net.IPv6len {
+		return nil, errors.NewInvalid("router solicitation requires IPv6 address")
+	}
+	rs := &layers.ICMPv6RouterSolicitation{
+		Options: layers.ICMPv6Options{{Type: layers.ICMPv6OptSourceAddress, Data: ourMAC}},
+	}
+	return icmpv6Packet(ourMAC, allRoutersMAC, ourIP, allRoutersIP, layers.ICMPv6TypeRouterSolicitation, rs)
+}
+
+// Serializes the given ICMPv6 message into an Ethernet frame with the IPv6 and ICMPv6 headers
+func icmpv6Packet(srcMAC net.HardwareAddr, dstMAC net.HardwareAddr, srcIP net.IP, dstIP net.IP,
+	icmpType uint8, message gopacket.SerializableLayer) ([]byte, error) {
+	eth := &layers.Ethernet{
+		SrcMAC:       srcMAC,
+		DstMAC:       dstMAC,
+		EthernetType: layers.EthernetTypeIPv6,
+	}
+	ip6 := &layers.IPv6{
+		Version:    6,
+		NextHeader: layers.IPProtocolICMPv6,
+		HopLimit:   255,
+		SrcIP:      srcIP,
+		DstIP:      dstIP,
+	}
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(icmpType, 0)}
+	if err := icmp.SetNetworkLayerForChecksum(ip6); err != nil {
+		return nil, err
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	err := gopacket.SerializeLayers(buf, opts, eth, ip6, icmp, message)
+	return buf.Bytes(), err
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/onosproject/onos-net-lib/pkg/packet"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNeighborSolicitationPacket(t *testing.T) {
+	b, err := NeighborSolicitationPacket(IPv6("2001:db8::2"), packet.MAC("00:ca:fe:01:01:01"), IPv6("2001:db8::1"))
+	assert.NoError(t, err)
+
+	p := gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	eth := p.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	assert.Equal(t, "33:33:ff:00:00:02", eth.DstMAC.String())
+	ip6 := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	assert.Equal(t, "ff02::1:ff00:2", ip6.DstIP.String())
+	ns := p.Layer(layers.LayerTypeICMPv6NeighborSolicitation).(*layers.ICMPv6NeighborSolicitation)
+	assert.Equal(t, "2001:db8::2", ns.TargetAddress.String())
+
+	_, err = NeighborSolicitationPacket(nil, packet.MAC("00:ca:fe:01:01:01"), IPv6("2001:db8::1"))
+	assert.Error(t, err)
+}
+
+func TestNeighborAdvertisementPacket(t *testing.T) {
+	ourMAC := packet.MAC("00:ca:fe:01:01:01")
+	b, err := NeighborAdvertisementPacket(ourMAC, IPv6("2001:db8::1"), nil, nil)
+	assert.NoError(t, err)
+
+	p := gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	ip6 := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	assert.Equal(t, "ff02::1", ip6.DstIP.String())
+	na := p.Layer(layers.LayerTypeICMPv6NeighborAdvertisement).(*layers.ICMPv6NeighborAdvertisement)
+	assert.False(t, na.Solicited())
+	assert.True(t, na.Override())
+
+	b, err = NeighborAdvertisementPacket(ourMAC, IPv6("2001:db8::1"), packet.MAC("00:ca:fe:01:01:02"), IPv6("2001:db8::2"))
+	assert.NoError(t, err)
+	p = gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	na = p.Layer(layers.LayerTypeICMPv6NeighborAdvertisement).(*layers.ICMPv6NeighborAdvertisement)
+	assert.True(t, na.Solicited())
+	assert.Equal(t, "2001:db8::1", na.TargetAddress.String())
+}
+
+func TestRouterSolicitationPacket(t *testing.T) {
+	b, err := RouterSolicitationPacket(packet.MAC("00:ca:fe:01:01:01"), IPv6("::ffff:10.10.11.1"))
+	assert.NoError(t, err)
+
+	p := gopacket.NewPacket(b, layers.LayerTypeEthernet, gopacket.Default)
+	ip6 := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	assert.Equal(t, "ff02::2", ip6.DstIP.String())
+	assert.NotNil(t, p.Layer(layers.LayerTypeICMPv6RouterSolicitation))
+}
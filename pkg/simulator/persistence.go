@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/json"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path/filepath"
+)
+
+// SetConfigDir sets the directory in which the gNMI configuration of each device is persisted, in addition to
+// memory, so that it survives restarts of the simulator; empty directory keeps the configuration in memory only
+func (s *Simulation) SetConfigDir(dir string) error {
+	if len(dir) > 0 {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.NewInvalid("unable to create config directory %s: %v", dir, err)
+		}
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.configDir = dir
+	return nil
+}
+
+// ClearDeviceConfig discards the persisted gNMI configuration of the specified device, so that the device starts
+// with its default configuration
+func (s *Simulation) ClearDeviceConfig(deviceID simapi.DeviceID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.deviceConfigs, deviceID)
+	if len(s.configDir) > 0 {
+		if err := os.Remove(s.deviceConfigFile(deviceID)); err != nil && !os.IsNotExist(err) {
+			return errors.NewInvalid("unable to remove config of device %s: %v", deviceID, err)
+		}
+	}
+	return nil
+}
+
+// Records the given configuration set request as part of the persisted configuration of the specified device;
+// the operations of earlier requests which the given request supersedes are discarded, so that the persisted
+// configuration is bounded by the size of the configuration rather than by the number of requests
+func (s *Simulation) recordDeviceConfig(deviceID simapi.DeviceID, request *gnmi.SetRequest) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	requests := make([]*gnmi.SetRequest, 0)
+	for _, r := range s.loadDeviceConfig(deviceID) {
+		if r = pruneSuperseded(r, request); r != nil {
+			requests = append(requests, r)
+		}
+	}
+	requests = append(requests, proto.Clone(request).(*gnmi.SetRequest))
+	s.deviceConfigs[deviceID] = requests
+	if len(s.configDir) == 0 {
+		return
+	}
+
+	records := make([]json.RawMessage, 0, len(requests))
+	for _, r := range requests {
+		b, err := protojson.Marshal(r)
+		if err != nil {
+			log.Warnf("Device %s: Unable to encode config: %+v", deviceID, err)
+			return
+		}
+		records = append(records, b)
+	}
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err == nil {
+		err = os.WriteFile(s.deviceConfigFile(deviceID), b, 0644)
+	}
+	if err != nil {
+		log.Warnf("Device %s: Unable to persist config: %+v", deviceID, err)
+	}
+}
+
+// Returns the persisted configuration set requests of the specified device, in the order in which they were
+// processed, loading them from the config directory if not yet in memory; must be called with the lock held
+func (s *Simulation) loadDeviceConfig(deviceID simapi.DeviceID) []*gnmi.SetRequest {
+	if requests, ok := s.deviceConfigs[deviceID]; ok || len(s.configDir) == 0 {
+		return requests
+	}
+
+	requests := make([]*gnmi.SetRequest, 0)
+	b, err := os.ReadFile(s.deviceConfigFile(deviceID))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warnf("Device %s: Unable to read persisted config: %+v", deviceID, err)
+		}
+		return requests
+	}
+	records := make([]json.RawMessage, 0)
+	if err = json.Unmarshal(b, &records); err != nil {
+		log.Warnf("Device %s: Unable to decode persisted config: %+v", deviceID, err)
+		return requests
+	}
+	for _, record := range records {
+		request := &gnmi.SetRequest{}
+		if err = protojson.Unmarshal(record, request); err != nil {
+			log.Warnf("Device %s: Unable to decode persisted config: %+v", deviceID, err)
+			return requests
+		}
+		requests = append(requests, request)
+	}
+	s.deviceConfigs[deviceID] = requests
+	return requests
+}
+
+// Returns the given earlier request without the operations superseded by the given later request, i.e. those
+// replaced or deleted by it, along with leaves set again by it; nil if no operations are left
+func pruneSuperseded(earlier *gnmi.SetRequest, later *gnmi.SetRequest) *gnmi.SetRequest {
+	superseded := func(path *gnmi.Path) bool {
+		full := config.JoinPaths(earlier.Prefix, path)
+		for _, p := range later.Delete {
+			if isPathAtOrBelow(full, config.JoinPaths(later.Prefix, p)) {
+				return true
+			}
+		}
+		for _, u := range later.Replace {
+			if isPathAtOrBelow(full, config.JoinPaths(later.Prefix, u.Path)) {
+				return true
+			}
+		}
+		for _, u := range later.Update {
+			// Only leaf updates are known to overwrite everything at their path; container updates are merged
+			if u.Val.GetJsonVal() == nil && u.Val.GetJsonIetfVal() == nil &&
+				isPathAtOrBelow(full, config.JoinPaths(later.Prefix, u.Path)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	pruned := &gnmi.SetRequest{Prefix: earlier.Prefix}
+	for _, p := range earlier.Delete {
+		if !superseded(p) {
+			pruned.Delete = append(pruned.Delete, p)
+		}
+	}
+	for _, u := range earlier.Replace {
+		if !superseded(u.Path) {
+			pruned.Replace = append(pruned.Replace, u)
+		}
+	}
+	for _, u := range earlier.Update {
+		if !superseded(u.Path) {
+			pruned.Update = append(pruned.Update, u)
+		}
+	}
+	if len(pruned.Delete)+len(pruned.Replace)+len(pruned.Update) == 0 {
+		return nil
+	}
+	return pruned
+}
+
+// Returns true if the given path is the same as the given ancestor path or lies below it
+func isPathAtOrBelow(path *gnmi.Path, ancestor *gnmi.Path) bool {
+	elems := path.GetElem()
+	if len(ancestor.GetElem()) > len(elems) {
+		return false
+	}
+	for i, elem := range ancestor.GetElem() {
+		if elem.Name != elems[i].Name || len(elem.Key) != len(elems[i].Key) {
+			return false
+		}
+		for k, v := range elem.Key {
+			if ev, ok := elems[i].Key[k]; !ok || ev != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns the path of the file in which the configuration of the specified device is persisted
+func (s *Simulation) deviceConfigFile(deviceID simapi.DeviceID) string {
+	return filepath.Join(s.configDir, string(deviceID)+".json")
+}
+
+// Returns the persisted configuration set requests of the specified device
+func (s *Simulation) getDeviceConfig(deviceID simapi.DeviceID) []*gnmi.SetRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.loadDeviceConfig(deviceID)
+}
+
+// Replays the persisted configuration of the device onto its default configuration, if the device has not been
+// started since its configuration was last reset; requests which no longer apply are skipped
+func (ds *DeviceSimulator) restoreConfig() {
+	ds.lock.Lock()
+	pristine := ds.pristine
+	ds.pristine = false
+	ds.lock.Unlock()
+	if !pristine || ds.simulation == nil {
+		return
+	}
+
+	requests := ds.simulation.getDeviceConfig(ds.Device.ID)
+	if len(requests) > 0 {
+		log.Infof("Device %s: Restoring %d persisted config changes", ds.Device.ID, len(requests))
+	}
+	for _, request := range requests {
+		if _, err := ds.processConfigSet(request.Prefix, request.Update, request.Replace, request.Delete); err != nil {
+			log.Warnf("Device %s: Unable to restore config change: %+v", ds.Device.ID, err)
+		}
+	}
+}
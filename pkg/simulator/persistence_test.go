@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPersistDeviceConfig(t *testing.T) {
+	topology := loadTestTopology(t)
+	dir := t.TempDir()
+
+	// Adds and starts the first device of the topology, returning its hostname and whether its first port is enabled
+	start := func(simulation *Simulation) (*DeviceSimulator, string, bool) {
+		ds, err := simulation.AddDeviceSimulator(topo.ConstructDevice(topology.Devices[0]), &testAgent{})
+		assert.NoError(t, err)
+		assert.NoError(t, ds.Start(simulation))
+		return ds, ds.config.GetPath("system/state/hostname").Value().GetStringVal(), ds.Ports["spine1/1"].Enabled
+	}
+
+	simulation := NewSimulation()
+	assert.NoError(t, simulation.SetConfigDir(dir))
+	ds, hostname, enabled := start(simulation)
+	assert.Equal(t, "spine1", hostname)
+	assert.True(t, enabled)
+	_, err := ds.ProcessConfigSet(nil, []*gnmi.Update{
+		{Path: gnmiutils.ToPath("system/config/hostname"),
+			Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "tor-1"}}},
+		{Path: gnmiutils.ToPath("interfaces/interface[name=1]/config/enabled"),
+			Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: false}}},
+	}, nil, nil)
+	assert.NoError(t, err)
+
+	// Repeated changes of the same leaf do not grow the persisted configuration
+	for _, name := range []string{"tor-2", "tor-3", "tor-1"} {
+		_, err = ds.ProcessConfigSet(nil, []*gnmi.Update{{Path: gnmiutils.ToPath("system/config/hostname"),
+			Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}}}}, nil, nil)
+		assert.NoError(t, err)
+	}
+	requests := simulation.getDeviceConfig(ds.Device.ID)
+	assert.Len(t, requests, 2)
+	assert.Len(t, requests[0].Update, 1)
+	assert.Equal(t, "enabled", requests[0].Update[0].Path.Elem[3].Name)
+
+	// Deleting a subtree discards the earlier changes within it
+	_, err = ds.ProcessConfigSet(nil, []*gnmi.Update{{Path: gnmiutils.ToPath("interfaces/interface[name=2]/config/mtu"),
+		Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 9000}}}}, nil, nil)
+	assert.NoError(t, err)
+	_, err = ds.ProcessConfigSet(nil, nil, nil, []*gnmi.Path{gnmiutils.ToPath("interfaces/interface[name=2]/config/mtu")})
+	assert.NoError(t, err)
+	assert.Len(t, simulation.getDeviceConfig(ds.Device.ID), 3)
+
+	// Restarted device keeps its configuration
+	ds.Stop(simapi.StopMode_ORDERLY_STOP)
+	assert.NoError(t, ds.Start(simulation))
+	assert.Equal(t, "tor-1", ds.config.GetPath("system/state/hostname").Value().GetStringVal())
+
+	// Re-added device gets its configuration back
+	assert.NoError(t, simulation.RemoveDeviceSimulator(ds.Device.ID))
+	ds, hostname, enabled = start(simulation)
+	assert.Equal(t, "tor-1", hostname)
+	assert.False(t, enabled)
+	ds.Stop(simapi.StopMode_ORDERLY_STOP)
+
+	// Configuration persisted on disk survives the simulation
+	simulation = NewSimulation()
+	assert.NoError(t, simulation.SetConfigDir(dir))
+	ds, hostname, enabled = start(simulation)
+	assert.Equal(t, "tor-1", hostname)
+	assert.False(t, enabled)
+	ds.Stop(simapi.StopMode_ORDERLY_STOP)
+
+	// Cleared configuration reverts to the default
+	assert.NoError(t, simulation.ClearDeviceConfig(ds.Device.ID))
+	assert.NoError(t, simulation.RemoveDeviceSimulator(ds.Device.ID))
+	ds, hostname, enabled = start(simulation)
+	assert.Equal(t, "spine1", hostname)
+	assert.True(t, enabled)
+	ds.Stop(simapi.StopMode_ORDERLY_STOP)
+}
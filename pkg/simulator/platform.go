@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"math/rand"
+	"time"
+)
+
+const (
+	platformInterval = 4 * time.Second
+
+	// Nominal fan speed in RPM; the remaining fans speed up by a step for each failed fan
+	nominalFanSpeed = 8000
+	fanSpeedStep    = 1000
+	// Temperature rise in degrees Celsius for each failed fan
+	failedFanHeat = 5.0
+	// Power draw of the device in watts, which is shared by the working power supplies
+	basePowerDraw = 300.0
+	cpuPowerDraw  = 2.0
+)
+
+// FailPlatformComponent fails the named fan or power supply of the specified device; the remaining fans speed up
+// and the remaining power supplies take over the load
+func (s *Simulation) FailPlatformComponent(deviceID simapi.DeviceID, name string) error {
+	return s.setPlatformComponentFailed(deviceID, name, true)
+}
+
+// RestorePlatformComponent restores the named fan or power supply of the specified device
+func (s *Simulation) RestorePlatformComponent(deviceID simapi.DeviceID, name string) error {
+	return s.setPlatformComponentFailed(deviceID, name, false)
+}
+
+// SetDeviceOverheat raises the temperature of all sensors of the specified device by the given number of degrees
+// Celsius above their nominal temperature; zero restores the nominal temperature
+func (s *Simulation) SetDeviceOverheat(deviceID simapi.DeviceID, degrees float64) error {
+	deviceSim, err := s.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return err
+	}
+	if degrees < 0 {
+		return errors.NewInvalid("invalid overheat %f", degrees)
+	}
+	return deviceSim.updatePlatform(func(p *config.Platform) error {
+		deviceSim.overheat = degrees
+		return nil
+	})
+}
+
+// GetPlatform returns the state of the platform components of the specified device
+func (s *Simulation) GetPlatform(deviceID simapi.DeviceID) (*config.Platform, error) {
+	deviceSim, err := s.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	return deviceSim.GetPlatform(), nil
+}
+
+func (s *Simulation) setPlatformComponentFailed(deviceID simapi.DeviceID, name string, failed bool) error {
+	deviceSim, err := s.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return err
+	}
+	return deviceSim.updatePlatform(func(p *config.Platform) error {
+		for _, fan := range p.Fans {
+			if fan.Name == name {
+				fan.Failed = failed
+				return nil
+			}
+		}
+		for _, psu := range p.PSUs {
+			if psu.Name == name {
+				psu.Failed = failed
+				return nil
+			}
+		}
+		return errors.NewNotFound("fan or power supply %s not found", name)
+	})
+}
+
+// GetPlatform returns the state of the platform components of the device
+func (ds *DeviceSimulator) GetPlatform() *config.Platform {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	p := *ds.platform
+	p.Fans, p.PSUs, p.Sensors = nil, nil, nil
+	for _, fan := range ds.platform.Fans {
+		f := *fan
+		p.Fans = append(p.Fans, &f)
+	}
+	for _, psu := range ds.platform.PSUs {
+		u := *psu
+		p.PSUs = append(p.PSUs, &u)
+	}
+	for _, sensor := range ds.platform.Sensors {
+		s := *sensor
+		p.Sensors = append(p.Sensors, &s)
+	}
+	return &p
+}
+
+// Adds the platform components of a healthy device to the device configuration
+func (ds *DeviceSimulator) addPlatformComponents() {
+	ds.platform = config.NewPlatform(fmt.Sprintf("FS%010d", ds.Device.ChassisID))
+	ds.nominalTemperatures = make(map[string]float64)
+	for _, sensor := range ds.platform.Sensors {
+		ds.nominalTemperatures[sensor.Name] = sensor.Temperature
+	}
+	config.AddPlatformComponents(ds.config, ds.platform)
+}
+
+// Periodically varies the state of the platform components, until the context is done
+func (ds *DeviceSimulator) simulatePlatform(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(platformInterval):
+			ds.updatePlatform(func(p *config.Platform) error {
+				p.CPUUtilization = uint8(clamp(float64(p.CPUUtilization)+float64(rand.Intn(7)-3), 5, 40))
+				p.MemoryUtilized = uint64(clamp(float64(p.MemoryUtilized)+float64(rand.Intn(129)-64)*(1<<20),
+					float64(p.MemoryAvailable)/8, float64(p.MemoryAvailable)/2))
+				return nil
+			})
+		}
+	}
+}
+
+// Applies the given update to the platform state, derives the fan speeds, temperatures and power outputs from the
+// failures and load, notifies subscribers of the new state and raises or clears the resulting health events
+func (ds *DeviceSimulator) updatePlatform(update func(p *config.Platform) error) error {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	p := ds.platform
+	if err := update(p); err != nil {
+		return err
+	}
+
+	failedFans := 0
+	for _, fan := range p.Fans {
+		if fan.Failed {
+			failedFans++
+		}
+	}
+	for _, fan := range p.Fans {
+		fan.Speed = 0
+		if !fan.Failed {
+			fan.Speed = uint64(nominalFanSpeed + fanSpeedStep*failedFans + rand.Intn(201) - 100)
+		}
+	}
+
+	for _, sensor := range p.Sensors {
+		wasAlarm := sensor.Alarm()
+		sensor.Temperature = ds.nominalTemperatures[sensor.Name] + ds.overheat + failedFanHeat*float64(failedFans) +
+			rand.Float64() - 0.5
+		if alarm := sensor.Alarm(); alarm != wasAlarm {
+			log.Infof("Device %s: Temperature alarm of sensor %s is now %t", ds.Device.ID, sensor.Name, alarm)
+		}
+	}
+
+	workingPSUs := 0
+	for _, psu := range p.PSUs {
+		if !psu.Failed {
+			workingPSUs++
+		}
+	}
+	for _, psu := range p.PSUs {
+		psu.OutputPower = 0
+		if !psu.Failed {
+			psu.OutputPower = (basePowerDraw + cpuPowerDraw*float64(p.CPUUtilization)) / float64(workingPSUs)
+		}
+	}
+
+	ds.sendUpdates(config.UpdatePlatform(ds.config, p))
+	ds.evaluateHealth()
+	return nil
+}
+
+// Returns the given value limited to the given range
+func clamp(v float64, min float64, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
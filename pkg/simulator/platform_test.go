@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPlatform(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, core, topology)
+	deviceID := ds.Device.ID
+
+	value := func(name string, path string) string {
+		return ds.config.GetPath("components/component[name=" + name + "]/" + path).Value().GetStringVal()
+	}
+	assert.Equal(t, "CHASSIS", value("chassis", "state/type"))
+	assert.Equal(t, "LINECARD", value("linecard-1", "state/type"))
+	assert.Equal(t, "FAN", value("fan-1", "state/type"))
+	assert.Equal(t, "POWER_SUPPLY", value("psu-2", "state/type"))
+	assert.Equal(t, "SENSOR", value("temp-asic", "state/type"))
+	assert.NotNil(t, ds.config.GetPath("components/component[name=cpu-0]/cpu/utilization/state/instant"))
+	assert.NotNil(t, ds.config.GetPath("components/component[name=chassis]/state/memory/utilized"))
+
+	// Failed fan stops, while the remaining fans speed up and the device heats up
+	assert.NoError(t, core.FailPlatformComponent(deviceID, "fan-2"))
+	assert.Equal(t, "DISABLED", value("fan-2", "state/oper-status"))
+	assert.Zero(t, ds.config.GetPath("components/component[name=fan-2]/fan/state/speed").Value().GetUintVal())
+	assert.Greater(t, ds.config.GetPath("components/component[name=fan-1]/fan/state/speed").Value().GetUintVal(), uint64(8500))
+	platform, err := core.GetPlatform(deviceID)
+	assert.NoError(t, err)
+	assert.Greater(t, platform.Sensors[0].Temperature, 29.0)
+	assert.NoError(t, core.RestorePlatformComponent(deviceID, "fan-2"))
+	assert.Equal(t, "ACTIVE", value("fan-2", "state/oper-status"))
+
+	// Lost power supply leaves the other one to carry the whole load
+	assert.NoError(t, core.FailPlatformComponent(deviceID, "psu-1"))
+	platform, err = core.GetPlatform(deviceID)
+	assert.NoError(t, err)
+	assert.Zero(t, platform.PSUs[0].OutputPower)
+	assert.Greater(t, platform.PSUs[1].OutputPower, basePowerDraw)
+	assert.False(t, ds.config.GetPath("components/component[name=psu-1]/power-supply/state/enabled").Value().GetBoolVal())
+
+	// Overheating raises the temperature alarms
+	alarm := func() bool {
+		return ds.config.GetPath("components/component[name=temp-inlet]/state/temperature/alarm-status").Value().GetBoolVal()
+	}
+	assert.False(t, alarm())
+	assert.NoError(t, core.SetDeviceOverheat(deviceID, 40))
+	assert.True(t, alarm())
+	assert.NoError(t, core.SetDeviceOverheat(deviceID, 0))
+	assert.False(t, alarm())
+
+	assert.Error(t, core.FailPlatformComponent(deviceID, "fan-9"))
+	assert.Error(t, core.SetDeviceOverheat(deviceID, -1))
+	assert.Error(t, core.FailPlatformComponent("foo", "fan-1"))
+}
@@ -0,0 +1,525 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+// Offset added, per channel, to the SDN number of a physical port to yield the SDN numbers of its breakout ports
+const breakoutSDNNumberOffset = 10000
+
+// Speeds which ports can be set to, in bits per second
+var validPortSpeeds = map[uint64]bool{
+	10e6: true, 100e6: true, 1e9: true, 2500e6: true, 5e9: true, 10e9: true, 25e9: true, 40e9: true, 50e9: true,
+	100e9: true, 200e9: true, 400e9: true, 800e9: true,
+}
+
+var portSpeedUnits = []struct {
+	suffix     string
+	multiplier uint64
+}{
+	{"Gbps", 1e9}, {"Mbps", 1e6}, {"GB", 1e9}, {"MB", 1e6}, {"G", 1e9}, {"M", 1e6},
+}
+
+// ParsePortSpeed parses the given port speed, e.g. 100Gbps, 100GB, 100G or SPEED_100GB, into bits per second;
+// empty speed is unknown and yields zero
+func ParsePortSpeed(speed string) (uint64, error) {
+	if len(speed) == 0 {
+		return 0, nil
+	}
+	value := strings.TrimPrefix(speed, "SPEED_")
+	for _, unit := range portSpeedUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			n, err := strconv.ParseUint(strings.TrimSuffix(value, unit.suffix), 10, 64)
+			if err == nil && validPortSpeeds[n*unit.multiplier] {
+				return n * unit.multiplier, nil
+			}
+			break
+		}
+	}
+	return 0, errors.NewInvalid("invalid port speed %s", speed)
+}
+
+// ParseBreakout parses the given breakout mode, e.g. 4x25G, into the number of child ports and their speed
+func ParseBreakout(mode string) (int, string, error) {
+	f := strings.SplitN(mode, "x", 2)
+	if len(f) != 2 {
+		return 0, "", errors.NewInvalid("invalid breakout mode %s", mode)
+	}
+	n, err := strconv.Atoi(f[0])
+	if err != nil {
+		return 0, "", errors.NewInvalid("invalid breakout mode %s", mode)
+	}
+	return n, f[1], nil
+}
+
+// Returns the ID of the physical port of the given port, which is either the physical port itself or one of
+// its breakout child ports
+func physicalPortID(id simapi.PortID) simapi.PortID {
+	physicalID, _, _ := strings.Cut(string(id), ":")
+	return simapi.PortID(physicalID)
+}
+
+// SetPortSpeed sets the speed and auto-negotiation of the specified port; links whose end ports no longer
+// agree on the speed go down
+func (s *Simulation) SetPortSpeed(id simapi.PortID, speed string, autoNegotiate bool) error {
+	deviceSim, err := s.GetDeviceSimulatorForPort(id)
+	if err != nil {
+		return err
+	}
+	if err = deviceSim.setPortSpeed(id, speed, autoNegotiate); err != nil {
+		return err
+	}
+	s.checkLinkSpeeds([]simapi.PortID{id})
+	return nil
+}
+
+// BreakoutPort splits the specified physical port into the given number of child ports of the given speed,
+// replacing any existing child ports; single breakout restores the physical port itself. The child ports together
+// may not exceed the speed of the physical port. Links using any of the ports which no longer exist are removed,
+// and links using the remaining ports go down unless their end ports still agree on speed. Ports to which host
+// NICs are attached cannot be removed.
+func (s *Simulation) BreakoutPort(id simapi.PortID, numBreakouts int, speed string) error {
+	deviceSim, err := s.GetDeviceSimulatorForPort(id)
+	if err != nil {
+		return err
+	}
+	gone, err := s.checkRemovedBreakoutPorts(deviceSim, id, numBreakouts)
+	if err != nil {
+		return err
+	}
+
+	ids, err := deviceSim.breakoutPort(id, numBreakouts, speed)
+	if err != nil {
+		return err
+	}
+	s.removePortLinks(gone)
+	s.checkLinkSpeeds(ids)
+	return nil
+}
+
+// Returns the IDs of the ports of the given device which would no longer exist after splitting the specified
+// physical port into the given number of child ports; returns an error if any of them has a host NIC attached
+func (s *Simulation) checkRemovedBreakoutPorts(deviceSim *DeviceSimulator, id simapi.PortID, numBreakouts int) ([]simapi.PortID, error) {
+	gone := deviceSim.getRemovedBreakoutPorts(id, numBreakouts)
+	for _, portID := range gone {
+		if hostSim, nic := s.GetNetworkInterfaceFromPort(portID); nic != nil {
+			return nil, errors.NewInvalid("port %s is used by host %s", portID, hostSim.Host.ID)
+		}
+	}
+	return gone, nil
+}
+
+// Removes the simulators of all links using any of the specified ports
+func (s *Simulation) removePortLinks(ids []simapi.PortID) {
+	s.lock.RLock()
+	links := make(map[simapi.LinkID]bool)
+	for _, id := range ids {
+		for _, ln := range []*linkOrNIC{s.usedEgressPorts[id], s.usedIngressPorts[id]} {
+			if ln != nil && ln.linkSim != nil {
+				links[ln.link.ID] = true
+			}
+		}
+	}
+	s.lock.RUnlock()
+
+	for linkID := range links {
+		log.Infof("Link %s: Removing, as its port no longer exists", linkID)
+		if err := s.RemoveLinkSimulator(linkID); err != nil {
+			log.Warnf("Link %s: Unable to remove: %+v", linkID, err)
+		}
+	}
+}
+
+// Checks whether the end ports of all links using any of the specified ports exist and agree on speed; takes
+// down links which do not and brings back up those which again do
+func (s *Simulation) checkLinkSpeeds(ids []simapi.PortID) {
+	s.lock.RLock()
+	sims := make(map[simapi.LinkID]*LinkSimulator)
+	for _, id := range ids {
+		for _, ln := range []*linkOrNIC{s.usedEgressPorts[id], s.usedIngressPorts[id]} {
+			if ln != nil && ln.linkSim != nil && !isExternalLink(ln.link) {
+				sims[ln.link.ID] = ln.linkSim
+			}
+		}
+	}
+	s.lock.RUnlock()
+
+	for _, sim := range sims {
+		speed, ok := s.negotiateLinkSpeed(sim.Link)
+		if changed := sim.setSpeedMismatch(!ok); changed {
+			status := simapi.LinkStatus_LINK_UP
+			if !ok {
+				log.Warnf("Link %s: Ports do not agree on speed", sim.Link.ID)
+				status = simapi.LinkStatus_LINK_DOWN
+			}
+			s.setLinkPortsStatus(sim, status)
+			sim.lock.Lock()
+			sim.Link.Status = status
+			sim.lock.Unlock()
+		}
+		for _, id := range []simapi.PortID{sim.Link.SrcID, sim.Link.TgtID} {
+			if deviceSim, err := s.GetDeviceSimulatorForPort(id); err == nil {
+				deviceSim.setNegotiatedPortSpeed(id, speed)
+			}
+		}
+	}
+}
+
+// Returns the speed which the end ports of the given link agree on; empty if unknown or not negotiated; false
+// if the ports do not exist or do not agree
+func (s *Simulation) negotiateLinkSpeed(link *simapi.Link) (string, bool) {
+	speeds := make([]string, 0, 2)
+	bps := make([]uint64, 0, 2)
+	negotiated := false
+	for _, id := range []simapi.PortID{link.SrcID, link.TgtID} {
+		deviceSim, err := s.GetDeviceSimulatorForPort(id)
+		if err != nil {
+			return "", false
+		}
+		speed, autoNegotiate, ok := deviceSim.getPortSpeed(id)
+		if !ok {
+			return "", false
+		}
+		b, _ := ParsePortSpeed(speed)
+		speeds = append(speeds, speed)
+		bps = append(bps, b)
+		negotiated = negotiated || autoNegotiate
+	}
+	if bps[0] == 0 || bps[1] == 0 {
+		return "", true
+	}
+	if !negotiated {
+		return "", bps[0] == bps[1]
+	}
+	// Auto-negotiation settles on the fastest speed supported by both ports
+	if bps[0] <= bps[1] {
+		return speeds[0], true
+	}
+	return speeds[1], true
+}
+
+// Marks the link as having, or not, end ports which do not agree on speed; returns true if this changed
+func (ls *LinkSimulator) setSpeedMismatch(mismatch bool) bool {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	changed := ls.speedMismatch != mismatch
+	ls.speedMismatch = mismatch
+	return changed
+}
+
+// Adds the platform components of all the physical ports of the device, along with their transceivers
+func (ds *DeviceSimulator) addPortComponents() {
+	for id, port := range ds.Ports {
+		ds.physicalPorts[id] = port
+		config.AddPortComponent(ds.config, port.Name, 1, port.Speed)
+		// Speeds are validated when the device is added; unknown speed yields the default form factor
+		speed, _ := ParsePortSpeed(port.Speed)
+		ds.portCapacities[id] = speed
+		t := config.NewTransceiver(port.Number, speed)
+		ds.transceivers[id] = t
+		config.AddTransceiver(ds.config, port.Name, t)
+	}
+}
+
+// Returns the IDs of the ports presently representing the specified physical port, i.e. either the physical port
+// itself or its breakout child ports
+func (ds *DeviceSimulator) getLogicalPortIDs(id simapi.PortID) []simapi.PortID {
+	children, ok := ds.breakouts[id]
+	if !ok {
+		return []simapi.PortID{id}
+	}
+	ids := make([]simapi.PortID, 0, len(children))
+	for _, child := range children {
+		ids = append(ids, child.ID)
+	}
+	return ids
+}
+
+// Returns the speed and auto-negotiation of the specified port; false if the port does not exist
+func (ds *DeviceSimulator) getPortSpeed(id simapi.PortID) (string, bool, bool) {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	port, ok := ds.Ports[id]
+	if !ok {
+		return "", false, false
+	}
+	return port.Speed, ds.autoNegotiate[id], true
+}
+
+func (ds *DeviceSimulator) setPortSpeed(id simapi.PortID, speed string, autoNegotiate bool) error {
+	if _, err := ParsePortSpeed(speed); err != nil {
+		return err
+	}
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	port, ok := ds.Ports[id]
+	if !ok {
+		return errors.NewNotFound("port %s not found", id)
+	}
+	log.Infof("Device %s: Setting speed of port %s to %s with auto-negotiation %t", ds.Device.ID, id, speed, autoNegotiate)
+	port.Speed = speed
+	ds.autoNegotiate[id] = autoNegotiate
+	config.SetPortSpeedConfig(ds.config, port.Name, speed, autoNegotiate)
+	return nil
+}
+
+// Records the speed negotiated by the specified port, if the port auto-negotiates
+func (ds *DeviceSimulator) setNegotiatedPortSpeed(id simapi.PortID, speed string) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if port, ok := ds.Ports[id]; ok {
+		if !ds.autoNegotiate[id] {
+			speed = ""
+		}
+		config.SetNegotiatedPortSpeed(ds.config, port.Name, speed)
+	}
+}
+
+// Returns an error if the specified physical port cannot be split into the given number of child ports of the
+// given speed; must be called with the lock held
+func (ds *DeviceSimulator) checkBreakout(id simapi.PortID, numBreakouts int, speed string) error {
+	if numBreakouts != 1 && numBreakouts != 2 && numBreakouts != 4 && numBreakouts != 8 {
+		return errors.NewInvalid("invalid number of breakout ports %d", numBreakouts)
+	}
+	bps, err := ParsePortSpeed(speed)
+	if err != nil {
+		return err
+	}
+	if _, ok := ds.physicalPorts[id]; !ok {
+		return errors.NewNotFound("physical port %s not found", id)
+	}
+	if capacity := ds.portCapacities[id]; capacity > 0 && uint64(numBreakouts)*bps > capacity {
+		return errors.NewInvalid("breakout %dx%s exceeds the speed of port %s", numBreakouts, speed, id)
+	}
+	for _, portID := range ds.getLogicalPortIDs(id) {
+		if lag := ds.getPortLAG(portID); lag != nil {
+			return errors.NewInvalid("port %s is a member of LAG %s", portID, lag.Name)
+		}
+	}
+	return nil
+}
+
+// Returns the IDs of the ports presently representing the specified physical port which would no longer exist
+// after splitting it into the given number of child ports
+func (ds *DeviceSimulator) getRemovedBreakoutPorts(id simapi.PortID, numBreakouts int) []simapi.PortID {
+	kept := map[simapi.PortID]bool{id: numBreakouts == 1}
+	for channel := 1; numBreakouts > 1 && channel <= numBreakouts; channel++ {
+		kept[breakoutPortID(id, channel)] = true
+	}
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	gone := make([]simapi.PortID, 0)
+	for _, portID := range ds.getLogicalPortIDs(id) {
+		if !kept[portID] {
+			gone = append(gone, portID)
+		}
+	}
+	return gone
+}
+
+// Returns the ID of the child port on the given channel of the specified physical port
+func breakoutPortID(id simapi.PortID, channel int) simapi.PortID {
+	return simapi.PortID(fmt.Sprintf("%s:%d", id, channel))
+}
+
+// Splits the specified physical port into the given number of child ports; returns the IDs of all the removed
+// and added ports
+func (ds *DeviceSimulator) breakoutPort(id simapi.PortID, numBreakouts int, speed string) ([]simapi.PortID, error) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if err := ds.checkBreakout(id, numBreakouts, speed); err != nil {
+		return nil, err
+	}
+	parent := ds.physicalPorts[id]
+	removed := ds.getLogicalPortIDs(id)
+
+	log.Infof("Device %s: Breaking out port %s into %dx%s", ds.Device.ID, id, numBreakouts, speed)
+	for _, portID := range removed {
+		port := ds.Ports[portID]
+		delete(ds.Ports, portID)
+		delete(ds.sdnPorts, port.InternalNumber)
+		delete(ds.autoNegotiate, portID)
+		delete(ds.faults, portID)
+		config.RemovePortInterface(ds.config, port.Name)
+	}
+
+	added := make([]*simapi.Port, 0, numBreakouts)
+	if numBreakouts == 1 {
+		delete(ds.breakouts, id)
+		parent.Speed = speed
+		added = append(added, parent)
+	} else {
+		for channel := 1; channel <= numBreakouts; channel++ {
+			added = append(added, &simapi.Port{
+				ID:             breakoutPortID(parent.ID, channel),
+				Name:           fmt.Sprintf("%s:%d", parent.Name, channel),
+				Number:         parent.Number,
+				InternalNumber: parent.InternalNumber + breakoutSDNNumberOffset*uint32(channel),
+				Speed:          speed,
+				Enabled:        true,
+			})
+		}
+		ds.breakouts[id] = added
+	}
+
+	t := ds.transceivers[id]
+	for _, port := range added {
+		port.Enabled = isTransceiverHealthy(t)
+		ds.Ports[port.ID] = port
+		ds.sdnPorts[port.InternalNumber] = port
+		config.AddPortInterface(ds.config, port)
+		config.SetInterfaceTransceiver(ds.config, port.Name, parent.Name)
+	}
+	config.SetPortBreakout(ds.config, parent.Name, numBreakouts, speed)
+	ds.Device.Ports = replacePorts(ds.Device.Ports, removed, added)
+	ds.evaluateHealth()
+
+	ids := removed
+	for _, port := range added {
+		ids = append(ids, port.ID)
+	}
+	return ids, nil
+}
+
+// Returns the given list of ports with the removed ports replaced by the added ports, in place of the first one
+func replacePorts(ports []*simapi.Port, removed []simapi.PortID, added []*simapi.Port) []*simapi.Port {
+	result := make([]*simapi.Port, 0, len(ports)+len(added))
+	for _, port := range ports {
+		switch {
+		case port.ID == removed[0]:
+			result = append(result, added...)
+		case !containsPortID(removed, port.ID):
+			result = append(result, port)
+		}
+	}
+	return result
+}
+
+func containsPortID(ids []simapi.PortID, id simapi.PortID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Applies any changes of breakout mode, port speed and auto-negotiation made to the configuration tree, e.g.
+// via gNMI set; reverts the configuration tree and returns an error if any of the changes are invalid
+func (ds *DeviceSimulator) applyPortConfig() error {
+	var err error
+	for _, c := range ds.getBreakoutConfigChanges() {
+		if err == nil {
+			err = ds.simulation.BreakoutPort(c.id, c.numBreakouts, c.speed)
+		}
+		if err != nil {
+			ds.revertPortBreakoutConfig(c.id)
+		}
+	}
+	// Speed changes are gathered only after the breakouts are applied, since these replace ports
+	for _, c := range ds.getSpeedConfigChanges() {
+		if err == nil {
+			err = ds.simulation.SetPortSpeed(c.id, c.speed, c.autoNegotiate)
+		}
+		if err != nil {
+			ds.revertPortSpeedConfig(c.id)
+		}
+	}
+	return err
+}
+
+// Returns an error if the configured breakout modes or port speeds cannot be applied
+func (ds *DeviceSimulator) validatePortConfig() error {
+	for _, c := range ds.getBreakoutConfigChanges() {
+		ds.lock.RLock()
+		err := ds.checkBreakout(c.id, c.numBreakouts, c.speed)
+		ds.lock.RUnlock()
+		if err != nil {
+			return err
+		}
+		if ds.simulation != nil {
+			if _, err = ds.simulation.checkRemovedBreakoutPorts(ds, c.id, c.numBreakouts); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range ds.getSpeedConfigChanges() {
+		if _, err := ParsePortSpeed(c.speed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Auxiliary structure to carry a change of port breakout mode, speed or auto-negotiation
+type portConfigChange struct {
+	id            simapi.PortID
+	numBreakouts  int
+	speed         string
+	autoNegotiate bool
+}
+
+// Returns the physical ports whose configured breakout mode differs from their actual one
+func (ds *DeviceSimulator) getBreakoutConfigChanges() []portConfigChange {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	changes := make([]portConfigChange, 0)
+	for id, port := range ds.physicalPorts {
+		numBreakouts, speed := config.GetPortBreakoutConfig(ds.config, port.Name)
+		children, broken := ds.breakouts[id]
+		switch {
+		case numBreakouts <= 1 && broken:
+			if len(speed) == 0 {
+				speed = port.Speed
+			}
+			changes = append(changes, portConfigChange{id: id, numBreakouts: 1, speed: speed})
+		case numBreakouts > 1 && (!broken || len(children) != numBreakouts || children[0].Speed != speed):
+			changes = append(changes, portConfigChange{id: id, numBreakouts: numBreakouts, speed: speed})
+		}
+	}
+	return changes
+}
+
+// Returns the ports whose configured speed or auto-negotiation differs from their actual ones
+func (ds *DeviceSimulator) getSpeedConfigChanges() []portConfigChange {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	changes := make([]portConfigChange, 0)
+	for id, port := range ds.Ports {
+		speed, autoNegotiate := config.GetPortSpeedConfig(ds.config, port.Name)
+		if speed != port.Speed || autoNegotiate != ds.autoNegotiate[id] {
+			changes = append(changes, portConfigChange{id: id, speed: speed, autoNegotiate: autoNegotiate})
+		}
+	}
+	return changes
+}
+
+// Reverts the configured breakout mode of the specified physical port to its actual breakout mode
+func (ds *DeviceSimulator) revertPortBreakoutConfig(id simapi.PortID) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	port := ds.physicalPorts[id]
+	if children, ok := ds.breakouts[id]; ok {
+		config.SetPortBreakout(ds.config, port.Name, len(children), children[0].Speed)
+	} else {
+		config.SetPortBreakout(ds.config, port.Name, 1, port.Speed)
+	}
+}
+
+// Reverts the configured speed and auto-negotiation of the specified port to its actual ones
+func (ds *DeviceSimulator) revertPortSpeedConfig(id simapi.PortID) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if port, ok := ds.Ports[id]; ok {
+		config.SetPortSpeedConfig(ds.config, port.Name, port.Speed, ds.autoNegotiate[id])
+	}
+}
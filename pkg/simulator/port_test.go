@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParsePortSpeed(t *testing.T) {
+	for speed, bps := range map[string]uint64{
+		"100Gbps": 100e9, "100GB": 100e9, "25G": 25e9, "SPEED_400GB": 400e9, "2500MB": 2500e6, "10Mbps": 10e6, "": 0,
+	} {
+		b, err := ParsePortSpeed(speed)
+		assert.NoError(t, err, speed)
+		assert.Equal(t, bps, b, speed)
+	}
+	for _, speed := range []string{"fast", "100", "30Gbps", "SPEED_UNKNOWN", "-1G"} {
+		_, err := ParsePortSpeed(speed)
+		assert.Error(t, err, speed)
+	}
+
+	n, speed, err := ParseBreakout("4x25G")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "25G", speed)
+	_, _, err = ParseBreakout("4")
+	assert.Error(t, err)
+
+	_, err = NewSimulation().AddDeviceSimulator(&simapi.Device{ID: "foo", Ports: []*simapi.Port{{ID: "foo/1", Speed: "1TB"}}}, &testAgent{})
+	assert.Error(t, err)
+}
+
+func TestPortBreakoutAndSpeed(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	forward, err := core.AddLinkSimulator(topo.ConstructLink(topology.Links[0]))
+	assert.NoError(t, err)
+	reverse, err := core.AddLinkSimulator(topo.ConstructReverseLink(topology.Links[0]))
+	assert.NoError(t, err)
+	ds, err := core.GetDeviceSimulatorForPort(forward.Link.SrcID)
+	assert.NoError(t, err)
+	portCount := len(ds.Device.Ports)
+
+	set := func(path string, val *gnmi.TypedValue, more ...*gnmi.Update) error {
+		updates := append([]*gnmi.Update{{Path: gnmiutils.ToPath(path), Val: val}}, more...)
+		_, err := ds.ProcessConfigSet(nil, updates, nil, nil)
+		return err
+	}
+	uintVal := func(v uint64) *gnmi.TypedValue { return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: v}} }
+	stringVal := func(v string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: v}}
+	}
+	breakoutPath := "components/component[name=port-1]/port/breakout-mode/groups/group[index=0]/config/"
+
+	// Breakout may not exceed the speed of the port
+	assert.Error(t, set(breakoutPath+"num-breakouts", uintVal(8),
+		&gnmi.Update{Path: gnmiutils.ToPath(breakoutPath + "breakout-speed"), Val: stringVal("25G")}))
+	assert.NotNil(t, ds.Ports["spine1/1"])
+
+	// Breaking out the port replaces it with child ports and removes its links
+	assert.NoError(t, set(breakoutPath+"num-breakouts", uintVal(4),
+		&gnmi.Update{Path: gnmiutils.ToPath(breakoutPath + "breakout-speed"), Val: stringVal("25G")}))
+	assert.Len(t, ds.Device.Ports, portCount+3)
+	assert.NotNil(t, ds.Ports["spine1/1:4"])
+	assert.Nil(t, ds.Ports["spine1/1"])
+	assert.Nil(t, ds.config.GetPath("interfaces/interface[name=1]"))
+	assert.Equal(t, "transceiver-1", ds.config.GetPath("interfaces/interface[name=1:2]/state/transceiver").Value().GetStringVal())
+	_, err = core.GetLinkSimulator(forward.Link.ID)
+	assert.True(t, errors.IsNotFound(err))
+	_, err = core.GetLinkSimulator(reverse.Link.ID)
+	assert.True(t, errors.IsNotFound(err))
+	assert.Nil(t, core.GetLinkSimulatorFromPort(forward.Link.SrcID))
+
+	// Restoring the port allows the links to be added again
+	assert.NoError(t, set(breakoutPath+"num-breakouts", uintVal(1),
+		&gnmi.Update{Path: gnmiutils.ToPath(breakoutPath + "breakout-speed"), Val: stringVal("100G")}))
+	assert.Len(t, ds.Device.Ports, portCount)
+	assert.NotNil(t, ds.Ports["spine1/1"])
+	forward, err = core.AddLinkSimulator(topo.ConstructLink(topology.Links[0]))
+	assert.NoError(t, err)
+	_, err = core.AddLinkSimulator(topo.ConstructReverseLink(topology.Links[0]))
+	assert.NoError(t, err)
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, forward.Link.Status)
+
+	// Invalid breakout is rejected along with the rest of the request
+	descriptionPath := "interfaces/interface[name=1]/config/description"
+	assert.Error(t, set(breakoutPath+"num-breakouts", uintVal(3),
+		&gnmi.Update{Path: gnmiutils.ToPath(descriptionPath), Val: stringVal("uplink")}))
+	n, _ := ds.ProcessConfigGet(nil, []*gnmi.Path{gnmiutils.ToPath(breakoutPath + "num-breakouts")})
+	assert.Equal(t, uint64(1), n[0].Update[0].Val.GetUintVal())
+	assert.Nil(t, ds.config.GetPath(descriptionPath))
+
+	// Speed mismatch takes the link down, unless auto-negotiated
+	speedPath := "interfaces/interface[name=1]/ethernet/config/"
+	assert.Error(t, set(speedPath+"port-speed", stringVal("fast")))
+	assert.NoError(t, set(speedPath+"port-speed", stringVal("SPEED_40GB")))
+	assert.Equal(t, simapi.LinkStatus_LINK_DOWN, forward.Link.Status)
+	assert.False(t, ds.Ports["spine1/1"].Enabled)
+	assert.NoError(t, set(speedPath+"auto-negotiate", &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}}))
+	assert.Equal(t, simapi.LinkStatus_LINK_UP, forward.Link.Status)
+	assert.True(t, ds.Ports["spine1/1"].Enabled)
+	assert.Equal(t, "SPEED_40GB",
+		ds.config.GetPath("interfaces/interface[name=1]/ethernet/state/negotiated-port-speed").Value().GetStringVal())
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	"github.com/openconfig/gnoi/types"
+	"math"
+	"math/rand"
+	"net"
+	"time"
+)
+
+const (
+	// Defaults of the ping and traceroute requests which leave them unspecified
+	defaultPingCount    = 5
+	defaultPingInterval = time.Second
+	defaultProbeWait    = 2 * time.Second
+	defaultPingSize     = 56
+	defaultMaxTTL       = 30
+
+	// Interval between probes of a flood ping
+	floodPingInterval = 10 * time.Millisecond
+
+	// Size of the ICMP header of echo requests and replies, the size of the traceroute probes and the initial
+	// time-to-live of the probe replies
+	icmpHeaderSize  = 8
+	tracerouteSize  = 60
+	probeInitialTTL = 64
+
+	// Time taken by a device to forward a probe and by the device or host at the last hop to respond to it
+	probeForwardDelay  = 20 * time.Microsecond
+	probeResponseDelay = 100 * time.Microsecond
+)
+
+// Route of probes from a device towards a destination host, along the usable links of the fabric
+type probeRoute struct {
+	// Device sending the probes, and the host and its address to which the probes are sent
+	source  simapi.DeviceID
+	host    *HostSimulator
+	address string
+	// Links leading to the device of the destination host, and the links in the opposite direction over which the
+	// replies return; a reverse link is nil if missing or unusable
+	links   []*LinkSimulator
+	reverse []*LinkSimulator
+	// Whether there is a path to the device of the destination host and whether the host is reachable from there
+	routed    bool
+	reachable bool
+}
+
+// Ping sends echo requests from the device to the destination host given by its IP address or host ID, and passes
+// the reply to each probe to the send function, followed by a summary of all probes. The probes follow the shortest
+// path of usable links and take the time given by the latencies of the traversed links; impaired links may drop them.
+func (ds *DeviceSimulator) Ping(ctx context.Context, request *gnoiapi.PingRequest, send func(*gnoiapi.PingResponse) error) error {
+	route, err := ds.findProbeRoute(request.Destination, request.L3Protocol)
+	if err != nil {
+		return err
+	}
+
+	count := int(request.Count)
+	if count == 0 {
+		count = defaultPingCount
+	}
+	interval := time.Duration(request.Interval)
+	if interval == 0 {
+		interval = defaultPingInterval
+	} else if interval < 0 {
+		interval = floodPingInterval
+	}
+	wait := time.Duration(request.Wait)
+	if wait <= 0 {
+		wait = defaultProbeWait
+	}
+	size := request.Size
+	if size <= 0 {
+		size = defaultPingSize
+	}
+	log.Infof("Device %s: Pinging %s (%s)", ds.Device.ID, request.Destination, route.address)
+
+	summary := &gnoiapi.PingResponse{Source: route.address}
+	rtts := make([]time.Duration, 0)
+	for seq := 1; count < 0 || seq <= count; seq++ {
+		if seq > 1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+		summary.Sent++
+		rtt, ok := route.probe(len(route.links) + 1)
+		if !ok || rtt > wait {
+			continue
+		}
+		rtts = append(rtts, rtt)
+		reply := &gnoiapi.PingResponse{
+			Source:   route.address,
+			Time:     int64(rtt),
+			Bytes:    size + icmpHeaderSize,
+			Sequence: int32(seq),
+			Ttl:      int32(probeInitialTTL - len(route.links)),
+		}
+		if err := send(reply); err != nil {
+			return err
+		}
+	}
+
+	summary.Received = int32(len(rtts))
+	summary.MinTime, summary.AvgTime, summary.MaxTime, summary.StdDev = rttStatistics(rtts)
+	return send(summary)
+}
+
+// Traceroute sends probes with increasing time-to-live from the device to the destination host given by its IP
+// address or host ID, and passes a description of the route, followed by the response from each hop, to the send
+// function. Devices along the route respond using their IDs, as they have no addresses of their own.
+func (ds *DeviceSimulator) Traceroute(ctx context.Context, request *gnoiapi.TracerouteRequest, send func(*gnoiapi.TracerouteResponse) error) error {
+	route, err := ds.findProbeRoute(request.Destination, request.L3Protocol)
+	if err != nil {
+		return err
+	}
+
+	ttl := int(request.InitialTtl)
+	if ttl == 0 {
+		ttl = 1
+	}
+	maxTTL := int(request.MaxTtl)
+	if maxTTL <= 0 {
+		maxTTL = defaultMaxTTL
+	}
+	wait := time.Duration(request.Wait)
+	if wait <= 0 {
+		wait = defaultProbeWait
+	}
+	log.Infof("Device %s: Tracing route to %s (%s)", ds.Device.ID, request.Destination, route.address)
+
+	if err := send(&gnoiapi.TracerouteResponse{
+		DestinationName:    string(route.host.Host.ID),
+		DestinationAddress: route.address,
+		Hops:               int32(maxTTL),
+		PacketSize:         tracerouteSize,
+	}); err != nil {
+		return err
+	}
+
+	last := len(route.links) + 1
+	for ; ttl <= maxTTL; ttl++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hop := &gnoiapi.TracerouteResponse{Hop: int32(ttl)}
+		switch {
+		case !route.routed:
+			// The device itself has no route to the destination
+			hop.Address, hop.Name, hop.State = string(ds.Device.ID), string(ds.Device.ID), gnoiapi.TracerouteResponse_NETWORK_UNREACHABLE
+		case !route.reachable && ttl >= last:
+			// The last device has no usable port towards the destination
+			hop.Address, hop.Name, hop.State = route.deviceAt(last-1), route.deviceAt(last-1), gnoiapi.TracerouteResponse_HOST_UNREACHABLE
+		default:
+			if rtt, ok := route.probe(ttl); !ok || rtt > wait {
+				hop.Address, hop.State = "*", gnoiapi.TracerouteResponse_NONE
+			} else if ttl >= last {
+				hop.Address, hop.Name, hop.Rtt = route.address, string(route.host.Host.ID), int64(rtt)
+			} else {
+				hop.Address, hop.Name, hop.Rtt = route.deviceAt(ttl), route.deviceAt(ttl), int64(rtt)
+			}
+		}
+		if err := send(hop); err != nil {
+			return err
+		}
+		if (hop.State == gnoiapi.TracerouteResponse_DEFAULT && ttl >= last) ||
+			(hop.State != gnoiapi.TracerouteResponse_DEFAULT && hop.State != gnoiapi.TracerouteResponse_NONE) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Finds the route of probes from the device to the destination host given by its IP address or host ID; the route
+// may lack a usable path to the host
+func (ds *DeviceSimulator) findProbeRoute(destination string, protocol types.L3Protocol) (*probeRoute, error) {
+	if ds.simulation == nil {
+		return nil, errors.NewUnavailable("device %s not part of a simulation", ds.Device.ID)
+	}
+	s := ds.simulation
+	host, nic, address := s.resolveProbeDestination(destination, protocol)
+	if host == nil {
+		return nil, errors.NewNotFound("unknown destination %s", destination)
+	}
+	route := &probeRoute{source: ds.Device.ID, host: host, address: address}
+	dstID, err := ExtractDeviceID(nic.ID)
+	if err != nil {
+		return nil, errors.NewInvalid("invalid port %s of destination %s", nic.ID, destination)
+	}
+
+	links, ok := findDevicePath(s.getTrafficAdjacency(), ds.Device.ID, dstID)
+	if !ok {
+		return route, nil
+	}
+	route.routed = true
+	route.reachable = s.isPortEnabled(nic.ID) && host.IsNetworkInterfaceUp(nic)
+	for _, link := range links {
+		linkSim, err := s.GetLinkSimulator(link.ID)
+		if err != nil {
+			return nil, err
+		}
+		route.links = append(route.links, linkSim)
+		if reverse := s.GetLinkSimulatorFromPort(link.TgtID); reverse != nil && reverse.Link.TgtID == link.SrcID {
+			route.reverse = append(route.reverse, reverse)
+		} else {
+			route.reverse = append(route.reverse, nil)
+		}
+	}
+	return route, nil
+}
+
+// Returns the host, its NIC and the address of the given probe destination, which is either the IP address of a
+// host NIC or a host ID; returns nil host if there is no such destination
+func (s *Simulation) resolveProbeDestination(destination string, protocol types.L3Protocol) (*HostSimulator, *simapi.NetworkInterface, string) {
+	ip := net.ParseIP(destination)
+	for _, hostSim := range s.GetHostSimulators() {
+		for _, nic := range hostSim.Host.Interfaces {
+			if ip != nil && (ip.Equal(net.ParseIP(nic.IpAddress)) || ip.Equal(net.ParseIP(nic.Ipv6Address))) {
+				return hostSim, nic, destination
+			}
+		}
+		if ip != nil || string(hostSim.Host.ID) != destination {
+			continue
+		}
+		for _, nic := range hostSim.Host.Interfaces {
+			if len(nic.IpAddress) > 0 && protocol != types.L3Protocol_IPV6 {
+				return hostSim, nic, nic.IpAddress
+			}
+			if len(nic.Ipv6Address) > 0 && protocol != types.L3Protocol_IPV4 {
+				return hostSim, nic, nic.Ipv6Address
+			}
+		}
+	}
+	return nil, nil, ""
+}
+
+// Returns the ID of the device at the given hop of the route, the first hop being the device after the source and
+// hop zero being the source itself
+func (r *probeRoute) deviceAt(hop int) string {
+	if hop < 1 || hop > len(r.links) {
+		return string(r.source)
+	}
+	id, _ := ExtractDeviceID(r.links[hop-1].Link.TgtID)
+	return string(id)
+}
+
+// Sends a single probe which expires at the given hop, the last hop being the destination host, and returns its
+// round-trip time; returns false if the probe or its reply is lost
+func (r *probeRoute) probe(hops int) (time.Duration, bool) {
+	if !r.routed || (hops > len(r.links) && !r.reachable) {
+		return 0, false
+	}
+	rtt := probeResponseDelay
+	for i := 0; i < hops && i < len(r.links); i++ {
+		if r.reverse[i] == nil {
+			return 0, false
+		}
+		for _, linkSim := range []*LinkSimulator{r.links[i], r.reverse[i]} {
+			delay, ok := linkSim.probeDelay()
+			if !ok {
+				return 0, false
+			}
+			rtt += delay + probeForwardDelay
+		}
+	}
+	if hops > len(r.links) {
+		// Probe and its reply also cross the link between the last device and the destination host
+		rtt += 2 * probeForwardDelay
+	}
+	return rtt, true
+}
+
+// Returns the delay of a probe moving across the link; returns false if the link drops it
+func (ls *LinkSimulator) probeDelay() (time.Duration, bool) {
+	if ls.IsDisabled() {
+		return 0, false
+	}
+	impairment := ls.Impairment()
+	if impairment == nil {
+		return 0, true
+	}
+	if impairment.Loss > 0 && rand.Float64() < impairment.Loss {
+		return 0, false
+	}
+	return impairment.delay(), true
+}
+
+// Returns the minimum, average, maximum and standard deviation of the given round-trip times, in nanoseconds
+func rttStatistics(rtts []time.Duration) (int64, int64, int64, int64) {
+	if len(rtts) == 0 {
+		return 0, 0, 0, 0
+	}
+	min, max, sum := rtts[0], rtts[0], time.Duration(0)
+	for _, rtt := range rtts {
+		if rtt < min {
+			min = rtt
+		}
+		if rtt > max {
+			max = rtt
+		}
+		sum += rtt
+	}
+	avg := float64(sum) / float64(len(rtts))
+	variance := 0.0
+	for _, rtt := range rtts {
+		variance += (float64(rtt) - avg) * (float64(rtt) - avg)
+	}
+	return int64(min), int64(avg), int64(max), int64(math.Sqrt(variance / float64(len(rtts))))
+}
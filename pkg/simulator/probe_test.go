@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPingAndTraceroute(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	addTestLinks(t, core, topology)
+	for _, hd := range topology.Hosts {
+		host := topo.ConstructHost(hd)
+		if host.ID == "h211" {
+			host.Interfaces[0].IpAddress = "10.0.21.1"
+		}
+		_, err := core.AddHostSimulator(host, nil)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, core.SetLinkImpairment("spine1/1-leaf11/1", &LinkImpairment{Latency: 5 * time.Millisecond}))
+
+	ds, err := core.GetDeviceSimulator("leaf11")
+	assert.NoError(t, err)
+	ping := func(destination string) []*gnoiapi.PingResponse {
+		responses := make([]*gnoiapi.PingResponse, 0)
+		err := ds.Ping(context.Background(), &gnoiapi.PingRequest{Destination: destination, Count: 3, Interval: int64(time.Millisecond)},
+			func(response *gnoiapi.PingResponse) error {
+				responses = append(responses, response)
+				return nil
+			})
+		assert.NoError(t, err)
+		return responses
+	}
+	traceroute := func(destination string) []*gnoiapi.TracerouteResponse {
+		responses := make([]*gnoiapi.TracerouteResponse, 0)
+		err := ds.Traceroute(context.Background(), &gnoiapi.TracerouteRequest{Destination: destination},
+			func(response *gnoiapi.TracerouteResponse) error {
+				responses = append(responses, response)
+				return nil
+			})
+		assert.NoError(t, err)
+		return responses
+	}
+
+	// Probes cross leaf11, spine1 and leaf21, and take the latency of the link from spine1 back to leaf11
+	responses := ping("10.0.21.1")
+	assert.Len(t, responses, 4)
+	for i, reply := range responses[:3] {
+		assert.Equal(t, "10.0.21.1", reply.Source)
+		assert.Equal(t, int32(i+1), reply.Sequence)
+		assert.Equal(t, int32(62), reply.Ttl)
+		assert.GreaterOrEqual(t, reply.Time, int64(5*time.Millisecond))
+		assert.Less(t, reply.Time, int64(6*time.Millisecond))
+	}
+	summary := responses[3]
+	assert.Equal(t, int32(3), summary.Sent)
+	assert.Equal(t, int32(3), summary.Received)
+	assert.Equal(t, responses[0].Time, summary.AvgTime)
+
+	responses = ping("h211")
+	assert.Len(t, responses, 4)
+	assert.Equal(t, "10.0.21.1", responses[0].Source)
+
+	hops := traceroute("h211")
+	assert.Len(t, hops, 4)
+	assert.Equal(t, "h211", hops[0].DestinationName)
+	assert.Equal(t, "10.0.21.1", hops[0].DestinationAddress)
+	assert.Equal(t, "spine1", hops[1].Name)
+	assert.Equal(t, "leaf21", hops[2].Name)
+	assert.Equal(t, "10.0.21.1", hops[3].Address)
+	assert.Equal(t, gnoiapi.TracerouteResponse_DEFAULT, hops[3].State)
+	assert.Less(t, hops[2].Rtt, hops[3].Rtt)
+
+	err = ds.Ping(context.Background(), &gnoiapi.PingRequest{Destination: "10.9.9.9"}, nil)
+	assert.True(t, errors.IsNotFound(err))
+
+	// Probes towards a host behind a disabled port go unanswered
+	leaf21, err := core.GetDeviceSimulator("leaf21")
+	assert.NoError(t, err)
+	assert.NoError(t, leaf21.DisablePort("leaf21/3", simapi.StopMode_CHAOTIC_STOP))
+	responses = ping("h211")
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(0), responses[0].Received)
+	hops = traceroute("h211")
+	assert.Len(t, hops, 4)
+	assert.Equal(t, "leaf21", hops[3].Address)
+	assert.Equal(t, gnoiapi.TracerouteResponse_HOST_UNREACHABLE, hops[3].State)
+
+	// Replies are lost on the lossy link back to leaf11
+	assert.NoError(t, leaf21.EnablePort("leaf21/3"))
+	assert.NoError(t, core.SetLinkImpairment("spine1/1-leaf11/1", &LinkImpairment{Loss: 1}))
+	responses = ping("h211")
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(3), responses[0].Sent)
+	assert.Equal(t, int32(0), responses[0].Received)
+	hops = traceroute("h211")
+	assert.Len(t, hops, defaultMaxTTL+1)
+	assert.Equal(t, gnoiapi.TracerouteResponse_NONE, hops[1].State)
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/google/gopacket/layers"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	p4info "github.com/p4lang/p4runtime/go/p4/config/v1"
+	p4api "github.com/p4lang/p4runtime/go/p4/v1"
+	"time"
+)
+
+const (
+	// DefaultRebootDowntime is the time for which a device stays unreachable during a cold reboot, unless set
+	// otherwise; warm reboots take a quarter of that time
+	DefaultRebootDowntime = 10 * time.Second
+
+	// Shortest delay of a reboot, which allows the response to the reboot request to be delivered
+	minRebootDelay = 100 * time.Millisecond
+)
+
+// State of a pending or active reboot
+type rebootState struct {
+	method  gnoiapi.RebootMethod
+	when    time.Time
+	reason  string
+	started bool
+	cancel  chan struct{}
+}
+
+// State of a powered down device, which is restored when the device is started again
+type powerDownState struct {
+	disabledPorts []simapi.PortID
+}
+
+// SetRebootDowntime sets the time for which devices stay unreachable during a cold reboot
+func (s *Simulation) SetRebootDowntime(downtime time.Duration) error {
+	if downtime < 0 {
+		return errors.NewInvalid("invalid reboot downtime %s", downtime)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.rebootDowntime = downtime
+	return nil
+}
+
+// GetRebootDowntime returns the time for which devices stay unreachable during a cold reboot
+func (s *Simulation) GetRebootDowntime() time.Duration {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.rebootDowntime
+}
+
+// Reboot schedules a reboot of the device using the given method after the given delay. The device agent closes
+// its streams and stays unreachable for the reboot downtime; the device comes back with its port counters reset,
+// any pending software activated and, unless rebooted warm, with an empty forwarding pipeline. Power down
+// stops the device right away and until it is started again, which brings it back as from a cold reboot.
+func (ds *DeviceSimulator) Reboot(method gnoiapi.RebootMethod, delay time.Duration, reason string) error {
+	switch method {
+	case gnoiapi.RebootMethod_UNKNOWN:
+		method = gnoiapi.RebootMethod_COLD
+	case gnoiapi.RebootMethod_COLD, gnoiapi.RebootMethod_WARM, gnoiapi.RebootMethod_POWERDOWN:
+	default:
+		return errors.NewNotSupported("reboot method %s not supported", method)
+	}
+	if delay < minRebootDelay {
+		delay = minRebootDelay
+	}
+
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if ds.reboot != nil {
+		return errors.NewConflict("reboot of device %s already pending", ds.Device.ID)
+	}
+	reboot := &rebootState{method: method, when: time.Now().Add(delay), reason: reason, cancel: make(chan struct{})}
+	ds.reboot = reboot
+	log.Infof("Device %s: Scheduled %s reboot in %s", ds.Device.ID, method, delay)
+	go ds.runReboot(reboot, delay)
+	return nil
+}
+
+// CancelReboot cancels the pending reboot of the device, unless it has already started
+func (ds *DeviceSimulator) CancelReboot() error {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if ds.reboot == nil {
+		return errors.NewNotFound("no reboot of device %s pending", ds.Device.ID)
+	}
+	if ds.reboot.started {
+		return errors.NewConflict("reboot of device %s already started", ds.Device.ID)
+	}
+	close(ds.reboot.cancel)
+	ds.reboot = nil
+	log.Infof("Device %s: Cancelled reboot", ds.Device.ID)
+	return nil
+}
+
+// RebootStatus returns the status of the pending or active reboot of the device, along with the number of
+// completed reboots
+func (ds *DeviceSimulator) RebootStatus() *gnoiapi.RebootStatusResponse {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	status := &gnoiapi.RebootStatusResponse{Count: ds.rebootCount}
+	if ds.reboot != nil {
+		status.Active = true
+		status.When = uint64(ds.reboot.when.UnixNano())
+		status.Reason = ds.reboot.reason
+		if wait := time.Until(ds.reboot.when); wait > 0 {
+			status.Wait = uint64(wait)
+		}
+	}
+	return status
+}
+
+// Waits out the reboot delay, unless the reboot is cancelled, and then reboots the device; the reboot is started
+// only if it is still the pending one, as it may have been cancelled just as its delay ran out
+func (ds *DeviceSimulator) runReboot(reboot *rebootState, delay time.Duration) {
+	select {
+	case <-reboot.cancel:
+		return
+	case <-time.After(delay):
+	}
+
+	ds.lock.Lock()
+	if ds.reboot != reboot {
+		ds.lock.Unlock()
+		return
+	}
+	reboot.started = true
+	ds.lock.Unlock()
+
+	log.Infof("Device %s: Rebooting (%s)", ds.Device.ID, reboot.method)
+	ds.Stop(simapi.StopMode_CHAOTIC_STOP)
+	disabled := ds.disableAllPorts()
+	defer ds.completeReboot(reboot)
+
+	if reboot.method == gnoiapi.RebootMethod_POWERDOWN {
+		// The device stays down until it is started again, which powers it back up
+		ds.lock.Lock()
+		ds.powerDown = &powerDownState{disabledPorts: disabled}
+		ds.lock.Unlock()
+		log.Infof("Device %s: Powered down", ds.Device.ID)
+		return
+	}
+
+	downtime := DefaultRebootDowntime
+	if ds.simulation != nil {
+		downtime = ds.simulation.GetRebootDowntime()
+	}
+	if reboot.method == gnoiapi.RebootMethod_WARM {
+		downtime = downtime / 4
+	}
+	time.Sleep(downtime)
+
+	ds.resetState(reboot.method != gnoiapi.RebootMethod_WARM)
+	ds.activateSoftware()
+	ds.enablePorts(disabled)
+	if err := ds.Start(ds.simulation); err != nil {
+		log.Errorf("Device %s: Unable to restart after reboot: %+v", ds.Device.ID, err)
+		return
+	}
+	log.Infof("Device %s: Rebooted", ds.Device.ID)
+}
+
+// Powers up the device after a power down, which loses the same state as a cold reboot, and brings back up
+// the ports taken down by the power down
+func (ds *DeviceSimulator) powerUp(powerDown *powerDownState) {
+	log.Infof("Device %s: Powering up", ds.Device.ID)
+	ds.resetState(true)
+	ds.activateSoftware()
+	ds.enablePorts(powerDown.disabledPorts)
+}
+
+// Enables the given ports of the device
+func (ds *DeviceSimulator) enablePorts(ids []simapi.PortID) {
+	for _, id := range ids {
+		if err := ds.EnablePort(id); err != nil {
+			log.Warnf("Device %s: Unable to enable port %s: %+v", ds.Device.ID, id, err)
+		}
+	}
+}
+
+// Marks the given reboot as completed; any other reboot scheduled meanwhile stays pending
+func (ds *DeviceSimulator) completeReboot(reboot *rebootState) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if ds.reboot == reboot {
+		ds.reboot = nil
+	}
+	ds.rebootCount++
+}
+
+// Disables all enabled ports of the device, as their links go down during a reboot, and returns their IDs
+func (ds *DeviceSimulator) disableAllPorts() []simapi.PortID {
+	ds.lock.RLock()
+	enabled := make([]simapi.PortID, 0, len(ds.Ports))
+	for id, port := range ds.Ports {
+		if port.Enabled {
+			enabled = append(enabled, id)
+		}
+	}
+	ds.lock.RUnlock()
+
+	for _, id := range enabled {
+		if err := ds.DisablePort(id, simapi.StopMode_CHAOTIC_STOP); err != nil {
+			log.Warnf("Device %s: Unable to disable port %s: %+v", ds.Device.ID, id, err)
+		}
+	}
+	return enabled
+}
+
+// Resets the state lost by rebooting the device, i.e. its port counters, mastership and, if requested, its
+// forwarding pipeline along with all its entries
+func (ds *DeviceSimulator) resetState(pipeline bool) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	config.ResetPortCounters(ds.config)
+	ds.roleConfigs = make(map[string]*roleConfig)
+	ds.streamResponders = nil
+	if !pipeline {
+		return
+	}
+
+	ds.forwardingPipelineConfig = &p4api.ForwardingPipelineConfig{
+		P4Info:         &p4info.P4Info{},
+		P4DeviceConfig: []byte{},
+		Cookie:         &p4api.ForwardingPipelineConfig_Cookie{Cookie: 0},
+	}
+	ds.Device.PipelineInfo = &simapi.PipelineInfo{}
+	ds.codec = nil
+	ds.tables, ds.counters, ds.meters, ds.profiles, ds.pre = nil, nil, nil, nil, nil
+	ds.puntToCPU = make(map[layers.EthernetType]uint32)
+	ds.puntProto = make(map[layers.EthernetType][]*ipProtoPunt)
+	ds.cpuActions = make(map[uint32]*cpuAction)
+	ds.cpuTables = make(map[uint32]*cpuTable)
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	p4info "github.com/p4lang/p4runtime/go/p4/config/v1"
+	p4api "github.com/p4lang/p4runtime/go/p4/v1"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDeviceReboot(t *testing.T) {
+	simulation := NewSimulation()
+	assert.NoError(t, simulation.SetRebootDowntime(200*time.Millisecond))
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, simulation, topology)
+	assert.NoError(t, ds.Start(simulation))
+	defer ds.Stop(simapi.StopMode_ORDERLY_STOP)
+
+	assert.NoError(t, ds.SetPipelineConfig(&p4api.ForwardingPipelineConfig{
+		P4Info: &p4info.P4Info{}, Cookie: &p4api.ForwardingPipelineConfig_Cookie{Cookie: 7},
+	}))
+	ds.addPortTraffic("spine1/1", &portTraffic{inBytes: 1000, inPkts: 10})
+	rebooted := func(count uint32) func() bool {
+		return func() bool { return ds.RebootStatus().Count == count }
+	}
+	leafValue := func(path string) uint64 {
+		ds.lock.RLock()
+		defer ds.lock.RUnlock()
+		return ds.config.GetPath(path).Value().GetUintVal()
+	}
+	portEnabled := func() bool {
+		ds.lock.RLock()
+		defer ds.lock.RUnlock()
+		return ds.Ports["spine1/1"].Enabled
+	}
+	booted := leafValue("system/state/boot-time")
+
+	// Warm reboot keeps the forwarding pipeline, but resets the counters
+	assert.NoError(t, ds.Reboot(gnoiapi.RebootMethod_WARM, 0, "upgrade"))
+	status := ds.RebootStatus()
+	assert.True(t, status.Active)
+	assert.Equal(t, "upgrade", status.Reason)
+	assert.True(t, errors.IsConflict(ds.Reboot(gnoiapi.RebootMethod_COLD, 0, "")))
+	assert.Eventually(t, rebooted(1), time.Second, 20*time.Millisecond)
+	assert.False(t, ds.RebootStatus().Active)
+	assert.Equal(t, uint64(7), ds.GetPipelineConfig().Cookie.Cookie)
+	assert.Zero(t, leafValue("interfaces/interface[name=1]/state/counters/in-octets"))
+	assert.Greater(t, leafValue("system/state/boot-time"), booted)
+
+	// Cold reboot takes the ports down for a while and loses the forwarding pipeline
+	assert.NoError(t, ds.Reboot(gnoiapi.RebootMethod_COLD, 0, "maintenance"))
+	assert.Eventually(t, func() bool { return !portEnabled() }, time.Second, 20*time.Millisecond)
+	assert.Eventually(t, rebooted(2), time.Second, 20*time.Millisecond)
+	assert.True(t, portEnabled())
+	assert.Zero(t, ds.GetPipelineConfig().Cookie.Cookie)
+	assert.Nil(t, ds.Tables())
+
+	// Pending reboot can be cancelled
+	assert.NoError(t, ds.Reboot(gnoiapi.RebootMethod_COLD, time.Second, ""))
+	assert.NoError(t, ds.CancelReboot())
+	assert.False(t, ds.RebootStatus().Active)
+	assert.True(t, errors.IsNotFound(ds.CancelReboot()))
+
+	// Reboot cancelled just as its delay runs out does not start, nor does it affect the reboot scheduled since
+	cancelled := &rebootState{method: gnoiapi.RebootMethod_COLD, cancel: make(chan struct{})}
+	assert.NoError(t, ds.Reboot(gnoiapi.RebootMethod_COLD, time.Second, "next"))
+	ds.runReboot(cancelled, 0)
+	assert.True(t, portEnabled())
+	assert.Equal(t, uint32(2), ds.RebootStatus().Count)
+	assert.Equal(t, "next", ds.RebootStatus().Reason)
+	assert.NoError(t, ds.CancelReboot())
+	assert.True(t, errors.IsNotSupported(ds.Reboot(gnoiapi.RebootMethod_HALT, 0, "")))
+
+	// Power down stops the device without waiting out the reboot downtime; starting it again powers it up
+	assert.NoError(t, simulation.SetRebootDowntime(time.Minute))
+	assert.NoError(t, ds.SetPipelineConfig(&p4api.ForwardingPipelineConfig{
+		P4Info: &p4info.P4Info{}, Cookie: &p4api.ForwardingPipelineConfig_Cookie{Cookie: 9},
+	}))
+	assert.NoError(t, ds.Reboot(gnoiapi.RebootMethod_POWERDOWN, 0, "shutdown"))
+	assert.Eventually(t, rebooted(3), time.Second, 20*time.Millisecond)
+	assert.False(t, portEnabled())
+	assert.NoError(t, ds.Start(simulation))
+	assert.True(t, portEnabled())
+	assert.Zero(t, ds.GetPipelineConfig().Cookie.Cookie)
+}
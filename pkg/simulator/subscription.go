@@ -0,0 +1,418 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	"github.com/onosproject/onos-api/go/onos/misc"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	utils "github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// Sample interval used when the subscription does not request one, and the shortest one supported
+	defaultSampleInterval = time.Second
+	minSampleInterval     = 100 * time.Millisecond
+	// Interval at which the subscribed leaves are checked for changes not pushed explicitly by the simulator
+	onChangeScanInterval = time.Second
+
+	// Number of responses queued for delivery, beyond which the subscriber is deemed too slow and is dropped
+	subscriptionQueueSize = 256
+)
+
+// GNMISubscription tracks a single gNMI subscription stream, supporting the ONCE, POLL and STREAM modes, the latter
+// with SAMPLE, ON_CHANGE and TARGET_DEFINED subscriptions; responses are delivered in order via the send function.
+// Responses are queued without blocking; a subscriber which falls behind by more than the queue size is dropped.
+type GNMISubscription struct {
+	ds         *DeviceSimulator
+	send       func(response *gnmi.SubscribeResponse) error
+	connection *misc.Connection
+
+	lock      sync.Mutex
+	list      *gnmi.SubscriptionList
+	entries   []*subscriptionEntry
+	responses chan *gnmi.SubscribeResponse
+	closed    bool
+	cancel    context.CancelFunc
+	delivered chan struct{}
+	done      chan struct{}
+	err       error
+	wg        sync.WaitGroup
+}
+
+// State of a single subscription within the subscription list
+type subscriptionEntry struct {
+	subscription *gnmi.Subscription
+	path         *gnmi.Path
+	// Last values sent, keyed by the leaf path
+	last     map[string]*gnmi.TypedValue
+	lastFull time.Time
+}
+
+// NewGNMISubscription creates a new subscription of the device, which delivers its responses using the given send
+// function; the subscription must be closed once the stream is done
+func (ds *DeviceSimulator) NewGNMISubscription(send func(response *gnmi.SubscribeResponse) error, connection *misc.Connection) *GNMISubscription {
+	s := &GNMISubscription{
+		ds:         ds,
+		send:       send,
+		connection: connection,
+		responses:  make(chan *gnmi.SubscribeResponse, subscriptionQueueSize),
+		delivered:  make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	go s.deliver()
+	return s
+}
+
+// Process processes the given subscribe request, i.e. the subscription list or a poll; returns true if the
+// subscription is done, as is the case for the ONCE mode
+func (s *GNMISubscription) Process(request *gnmi.SubscribeRequest) (bool, error) {
+	switch {
+	case request.GetSubscribe() != nil:
+		return s.subscribe(request.GetSubscribe())
+	case request.GetPoll() != nil:
+		s.lock.Lock()
+		list := s.list
+		s.lock.Unlock()
+		if list == nil || list.Mode != gnmi.SubscriptionList_POLL {
+			return false, errors.NewInvalid("poll received without a POLL mode subscription")
+		}
+		return false, s.sendAll(true)
+	}
+	return false, errors.NewInvalid("unknown subscription message type")
+}
+
+func (s *GNMISubscription) subscribe(list *gnmi.SubscriptionList) (bool, error) {
+	s.lock.Lock()
+	if s.list != nil {
+		s.lock.Unlock()
+		return false, errors.NewInvalid("duplicate subscription message detected")
+	}
+	s.list = list
+	for _, sub := range list.Subscription {
+		s.entries = append(s.entries, &subscriptionEntry{
+			subscription: sub,
+			path:         config.JoinPaths(list.Prefix, sub.Path),
+			last:         make(map[string]*gnmi.TypedValue),
+		})
+	}
+	s.lock.Unlock()
+
+	if !list.UpdatesOnly {
+		if err := s.sendAll(false); err != nil {
+			return false, err
+		}
+	} else if list.Mode == gnmi.SubscriptionList_STREAM {
+		// Record the present values, so that only their subsequent changes are streamed
+		for _, entry := range s.entries {
+			if err := s.recordValues(entry); err != nil {
+				return false, err
+			}
+		}
+	}
+	s.enqueue(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}})
+
+	switch list.Mode {
+	case gnmi.SubscriptionList_ONCE:
+		return true, nil
+	case gnmi.SubscriptionList_STREAM:
+		ctx, cancel := context.WithCancel(context.Background())
+		s.lock.Lock()
+		s.cancel = cancel
+		if s.err != nil {
+			cancel()
+		}
+		s.lock.Unlock()
+		for _, entry := range s.entries {
+			s.wg.Add(1)
+			go s.stream(ctx, entry)
+		}
+		s.ds.GNMIConfigurable.AddSubscribeResponder(s)
+	}
+	return false, nil
+}
+
+// Close stops the subscription, waiting for any queued responses to be delivered, unless the subscription has been
+// dropped, in which case the pending responses are discarded
+func (s *GNMISubscription) Close() {
+	s.ds.GNMIConfigurable.RemoveSubscribeResponder(s)
+	s.lock.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.lock.Unlock()
+
+	// Wait for the streaming tasks to stop before closing the queue; the delivery task stops once the queue is drained
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if !s.closed {
+			s.closed = true
+			close(s.responses)
+		}
+	}()
+	s.wg.Wait()
+	select {
+	case <-s.delivered:
+	case <-s.done:
+	}
+}
+
+// Done returns a channel which is closed once the subscription has been dropped by the simulator
+func (s *GNMISubscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason why the subscription has been dropped; nil if it has not been
+func (s *GNMISubscription) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+// GetConnection returns the peer connection info of the subscription stream
+func (s *GNMISubscription) GetConnection() *misc.Connection {
+	return s.connection
+}
+
+// Send forwards the relevant updates and deletes of the given response, pushed explicitly by the simulator, to the
+// on-change subscriptions; deletes are forwarded as the deletes of the previously sent leaves they remove
+func (s *GNMISubscription) Send(response *gnmi.SubscribeResponse) {
+	notification := response.GetUpdate()
+	if notification == nil {
+		return
+	}
+	s.lock.Lock()
+	encoding := s.list.GetEncoding()
+	updates := make([]*gnmi.Update, 0, len(notification.Update))
+	deletes := make([]*gnmi.Path, 0)
+	for _, entry := range s.entries {
+		if entry.subscription.Mode == gnmi.SubscriptionMode_SAMPLE {
+			continue
+		}
+		for _, update := range notification.Update {
+			path := config.JoinPaths(notification.Prefix, update.Path)
+			if !pathMatches(entry.path, path) {
+				continue
+			}
+			key := utils.ToString(path)
+			if last, ok := entry.last[key]; ok && proto.Equal(last, update.Val) {
+				continue
+			}
+			entry.last[key] = proto.Clone(update.Val).(*gnmi.TypedValue)
+			updates = append(updates, &gnmi.Update{Path: path, Val: config.EncodeValue(update.Val, encoding)})
+		}
+		for _, d := range notification.Delete {
+			deleted := config.JoinPaths(notification.Prefix, d)
+			for key := range entry.last {
+				if path := utils.ToPath(key); isPathAtOrBelow(path, deleted) {
+					delete(entry.last, key)
+					deletes = append(deletes, path)
+				}
+			}
+		}
+	}
+	s.lock.Unlock()
+
+	if len(updates) > 0 || len(deletes) > 0 {
+		s.enqueue(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+			Timestamp: notificationTimestamp(notification), Update: updates, Delete: deletes,
+		}}})
+	}
+}
+
+// Delivers the queued responses in order until the queue is closed; stops sending on the first error or once the
+// subscription is dropped
+func (s *GNMISubscription) deliver() {
+	defer close(s.delivered)
+	var err error
+	for response := range s.responses {
+		select {
+		case <-s.done:
+			err = s.Err()
+		default:
+		}
+		if err == nil {
+			if err = s.send(response); err != nil {
+				log.Warnf("Unable to send subscribe response: %+v", err)
+			}
+		}
+	}
+}
+
+// Queues up the given response for delivery, unless the subscription is closed or dropped; never blocks, as it may
+// be called while the device simulator is locked, and drops the subscription instead if the queue is full
+func (s *GNMISubscription) enqueue(response *gnmi.SubscribeResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed || s.err != nil {
+		return
+	}
+	select {
+	case s.responses <- response:
+	default:
+		log.Warnf("Device %s: Dropping gNMI subscription whose subscriber is too slow", s.ds.Device.ID)
+		s.err = errors.NewUnavailable("subscriber is too slow; more than %d responses are pending", subscriptionQueueSize)
+		if s.cancel != nil {
+			s.cancel()
+		}
+		close(s.done)
+	}
+}
+
+// Sends the present values of all subscriptions, optionally followed by the sync response
+func (s *GNMISubscription) sendAll(sync bool) error {
+	for _, entry := range s.entries {
+		if err := s.sendValues(entry, false); err != nil {
+			return err
+		}
+	}
+	if sync {
+		s.enqueue(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}})
+	}
+	return nil
+}
+
+// Periodically sends the values of the given subscription per its mode, until the context is done
+func (s *GNMISubscription) stream(ctx context.Context, entry *subscriptionEntry) {
+	defer s.wg.Done()
+	sub := entry.subscription
+	interval := onChangeScanInterval
+	changesOnly := true
+	if sub.Mode == gnmi.SubscriptionMode_SAMPLE {
+		interval = time.Duration(sub.SampleInterval)
+		if interval == 0 {
+			interval = defaultSampleInterval
+		} else if interval < minSampleInterval {
+			interval = minSampleInterval
+		}
+		changesOnly = sub.SuppressRedundant
+	}
+	heartbeat := time.Duration(sub.HeartbeatInterval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.lock.Lock()
+			full := !changesOnly || (heartbeat > 0 && time.Since(entry.lastFull) >= heartbeat)
+			s.lock.Unlock()
+			if err := s.sendValues(entry, !full); err != nil {
+				log.Warnf("Unable to sample %s: %+v", utils.ToString(entry.path), err)
+			}
+		}
+	}
+}
+
+// Sends the present values of the leaves of the given subscription; only the changed values and the deleted leaves
+// are sent if requested
+func (s *GNMISubscription) sendValues(entry *subscriptionEntry, changesOnly bool) error {
+	updates, err := s.ds.getLeafValues(entry.path, s.list.UseModels)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	notification := &gnmi.Notification{Timestamp: time.Now().UnixNano()}
+	keys := make([]string, 0, len(updates))
+	for key := range updates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		update := updates[key]
+		if last, ok := entry.last[key]; changesOnly && ok && proto.Equal(last, update.Val) {
+			continue
+		}
+		entry.last[key] = update.Val
+		notification.Update = append(notification.Update,
+			&gnmi.Update{Path: update.Path, Val: config.EncodeValue(update.Val, s.list.Encoding)})
+	}
+	for key := range entry.last {
+		if _, ok := updates[key]; !ok {
+			delete(entry.last, key)
+			notification.Delete = append(notification.Delete, utils.ToPath(key))
+		}
+	}
+	if !changesOnly {
+		entry.lastFull = time.Now()
+	}
+	s.lock.Unlock()
+
+	if len(notification.Update) > 0 || len(notification.Delete) > 0 || !changesOnly {
+		s.enqueue(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: notification}})
+	}
+	return nil
+}
+
+// Records the present values of the leaves of the given subscription as sent
+func (s *GNMISubscription) recordValues(entry *subscriptionEntry) error {
+	updates, err := s.ds.getLeafValues(entry.path, s.list.UseModels)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key, update := range updates {
+		entry.last[key] = update.Val
+	}
+	entry.lastFull = time.Now()
+	return nil
+}
+
+// Returns the present values of all leaves at the given path, keyed by their path; paths which do not
+// match any leaves yield no values
+func (ds *DeviceSimulator) getLeafValues(path *gnmi.Path, models []*gnmi.ModelData) (map[string]*gnmi.Update, error) {
+	notifications, err := ds.ProcessGetRequest(&gnmi.GetRequest{Path: []*gnmi.Path{path},
+		Type: gnmi.GetRequest_ALL, Encoding: gnmi.Encoding_PROTO, UseModels: models})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	updates := make(map[string]*gnmi.Update)
+	for _, notification := range notifications {
+		for _, update := range notification.Update {
+			updates[utils.ToString(update.Path)] = update
+		}
+	}
+	return updates, nil
+}
+
+// Returns true if the given path is at or below the given pattern, whose elements and key values may be wildcards
+func pathMatches(pattern *gnmi.Path, path *gnmi.Path) bool {
+	elems := path.GetElem()
+	for i, pe := range pattern.GetElem() {
+		if pe.Name == "..." {
+			return true
+		}
+		if i >= len(elems) || (pe.Name != "*" && pe.Name != elems[i].Name) {
+			return false
+		}
+		for k, v := range pe.Key {
+			if v != "*" && v != "..." && elems[i].Key[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns the timestamp of the given notification; present time if not set
+func notificationTimestamp(notification *gnmi.Notification) int64 {
+	if notification.Timestamp != 0 {
+		return notification.Timestamp
+	}
+	return time.Now().UnixNano()
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	utils "github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Collects the responses sent to a subscriber
+type testSubscriber struct {
+	lock      sync.Mutex
+	responses []*gnmi.SubscribeResponse
+}
+
+func (ts *testSubscriber) send(response *gnmi.SubscribeResponse) error {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+	ts.responses = append(ts.responses, response)
+	return nil
+}
+
+// Returns the number of sync responses and the paths of all updated leaves received so far
+func (ts *testSubscriber) received() (int, []string) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+	syncs, paths := 0, make([]string, 0)
+	for _, response := range ts.responses {
+		if response.GetSyncResponse() {
+			syncs++
+		}
+		for _, update := range response.GetUpdate().GetUpdate() {
+			paths = append(paths, utils.ToString(update.Path))
+		}
+	}
+	return syncs, paths
+}
+
+func TestDeviceSubscribe(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, core, topology)
+
+	counters := utils.ToPath("interfaces/interface[name=1]/state/counters")
+	request := func(mode gnmi.SubscriptionList_Mode, subscription *gnmi.Subscription) *gnmi.SubscribeRequest {
+		subscription.Path = counters
+		return &gnmi.SubscribeRequest{Request: &gnmi.SubscribeRequest_Subscribe{Subscribe: &gnmi.SubscriptionList{
+			Mode: mode, Subscription: []*gnmi.Subscription{subscription},
+		}}}
+	}
+	poll := &gnmi.SubscribeRequest{Request: &gnmi.SubscribeRequest_Poll{Poll: &gnmi.Poll{}}}
+
+	// Once mode sends the present values followed by the sync response
+	ts := &testSubscriber{}
+	s := ds.NewGNMISubscription(ts.send, nil)
+	done, err := s.Process(request(gnmi.SubscriptionList_ONCE, &gnmi.Subscription{}))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	_, err = s.Process(poll)
+	assert.Error(t, err)
+	s.Close()
+	syncs, paths := ts.received()
+	assert.Equal(t, 1, syncs)
+	assert.Contains(t, paths, "interfaces/interface[name=1]/state/counters/in-octets")
+	assert.True(t, ts.responses[len(ts.responses)-1].GetSyncResponse())
+	count := len(paths)
+
+	// Each poll sends the present values again
+	ts = &testSubscriber{}
+	s = ds.NewGNMISubscription(ts.send, nil)
+	done, err = s.Process(request(gnmi.SubscriptionList_POLL, &gnmi.Subscription{}))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	_, err = s.Process(poll)
+	assert.NoError(t, err)
+	_, err = s.Process(request(gnmi.SubscriptionList_POLL, &gnmi.Subscription{}))
+	assert.Error(t, err)
+	s.Close()
+	syncs, paths = ts.received()
+	assert.Equal(t, 2, syncs)
+	assert.Len(t, paths, 2*count)
+
+	// Sampling with suppressed redundancy sends only the changed counters
+	ts = &testSubscriber{}
+	s = ds.NewGNMISubscription(ts.send, nil)
+	_, err = s.Process(request(gnmi.SubscriptionList_STREAM, &gnmi.Subscription{Mode: gnmi.SubscriptionMode_SAMPLE,
+		SampleInterval: uint64(100 * time.Millisecond), SuppressRedundant: true}))
+	assert.NoError(t, err)
+	time.Sleep(300 * time.Millisecond)
+	_, paths = ts.received()
+	assert.Len(t, paths, count)
+	ds.addPortTraffic("spine1/1", &portTraffic{inBytes: 1000, inPkts: 10})
+	assert.Eventually(t, func() bool {
+		_, paths = ts.received()
+		return len(paths) == count+2
+	}, time.Second, 50*time.Millisecond)
+	s.Close()
+
+	// Heartbeats resend all sampled values, even if redundant
+	ts = &testSubscriber{}
+	s = ds.NewGNMISubscription(ts.send, nil)
+	_, err = s.Process(request(gnmi.SubscriptionList_STREAM, &gnmi.Subscription{Mode: gnmi.SubscriptionMode_SAMPLE,
+		SampleInterval: uint64(100 * time.Millisecond), SuppressRedundant: true,
+		HeartbeatInterval: uint64(200 * time.Millisecond)}))
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, paths = ts.received()
+		return len(paths) >= 2*count
+	}, time.Second, 50*time.Millisecond)
+	s.Close()
+
+	// On change subscriptions receive the counter updates pushed by the simulator right away
+	ts = &testSubscriber{}
+	s = ds.NewGNMISubscription(ts.send, nil)
+	_, err = s.Process(&gnmi.SubscribeRequest{Request: &gnmi.SubscribeRequest_Subscribe{Subscribe: &gnmi.SubscriptionList{
+		Mode: gnmi.SubscriptionList_STREAM, UpdatesOnly: true,
+		Subscription: []*gnmi.Subscription{{Path: counters, Mode: gnmi.SubscriptionMode_ON_CHANGE}},
+	}}})
+	assert.NoError(t, err)
+	ds.addPortTraffic("spine1/1", &portTraffic{outBytes: 1000, outPkts: 10})
+	assert.Eventually(t, func() bool {
+		syncs, paths = ts.received()
+		return syncs == 1 && len(paths) == 2
+	}, 500*time.Millisecond, 20*time.Millisecond)
+	assert.Contains(t, paths, "interfaces/interface[name=1]/state/counters/out-octets")
+	s.Close()
+}
+
+// TestDeviceSubscribeSlowSubscriber tests that a subscriber which falls behind is dropped rather than blocking
+func TestDeviceSubscribeSlowSubscriber(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, core, topology)
+
+	// The subscriber blocks on its first response until released
+	release := make(chan struct{})
+	s := ds.NewGNMISubscription(func(response *gnmi.SubscribeResponse) error {
+		<-release
+		return nil
+	}, nil)
+	response := &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}}
+	for i := 0; i <= subscriptionQueueSize+1; i++ {
+		s.enqueue(response)
+	}
+	select {
+	case <-s.Done():
+	case <-time.After(time.Second):
+		assert.Fail(t, "slow subscription not dropped")
+	}
+	assert.True(t, errors.IsUnavailable(s.Err()))
+
+	// Closing the dropped subscription does not wait for the stalled subscriber
+	s.Close()
+	close(release)
+}
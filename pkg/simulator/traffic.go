@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPacketSize = 1000
+	trafficInterval   = 4 * time.Second
+)
+
+// TrafficFlow describes a steady flow of traffic between two hosts
+type TrafficFlow struct {
+	Src simapi.HostID
+	Dst simapi.HostID
+	// Rate in bits per second
+	Rate uint64
+	// PacketSize in bytes
+	PacketSize uint64
+}
+
+// NewTrafficFlow creates a new traffic flow from the given host IDs, rate, e.g. 10Mbps, and packet size;
+// zero packet size yields the default size
+func NewTrafficFlow(src string, dst string, rate string, packetSize int) (*TrafficFlow, error) {
+	bps, err := parseRate(rate)
+	if err != nil {
+		return nil, err
+	}
+	if packetSize < 0 {
+		return nil, errors.NewInvalid("invalid packet size %d", packetSize)
+	}
+	flow := &TrafficFlow{Src: simapi.HostID(src), Dst: simapi.HostID(dst), Rate: bps, PacketSize: uint64(packetSize)}
+	if flow.PacketSize == 0 {
+		flow.PacketSize = defaultPacketSize
+	}
+	return flow, nil
+}
+
+var rateUnits = []struct {
+	suffix     string
+	multiplier uint64
+}{
+	{"Tbps", 1e12}, {"Gbps", 1e9}, {"Mbps", 1e6}, {"Kbps", 1e3}, {"bps", 1},
+}
+
+// Parses the given rate, e.g. 100Mbps, into bits per second; rate without units is in bits per second
+func parseRate(rate string) (uint64, error) {
+	multiplier := uint64(1)
+	value := rate
+	for _, unit := range rateUnits {
+		if strings.HasSuffix(rate, unit.suffix) {
+			value, multiplier = strings.TrimSuffix(rate, unit.suffix), unit.multiplier
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f < 0 {
+		return 0, errors.NewInvalid("invalid rate %s", rate)
+	}
+	return uint64(f * float64(multiplier)), nil
+}
+
+// Accumulated traffic through a single device port
+type portTraffic struct {
+	inBytes  uint64
+	outBytes uint64
+	inPkts   uint64
+	outPkts  uint64
+}
+
+// SetTrafficMatrix sets the traffic matrix which drives the port counters of all devices; empty matrix reverts to
+// simulating random port counters.
+func (s *Simulation) SetTrafficMatrix(flows []*TrafficFlow) error {
+	for _, flow := range flows {
+		if _, err := s.GetHostSimulator(flow.Src); err != nil {
+			return err
+		}
+		if _, err := s.GetHostSimulator(flow.Dst); err != nil {
+			return err
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	log.Infof("Setting traffic matrix with %d flows", len(flows))
+	s.trafficFlows = flows
+	if len(flows) > 0 && s.trafficDone == nil {
+		// Start counting from scratch so that the counters are consistent across the fabric
+		for _, deviceSim := range s.deviceSimulators {
+			deviceSim.resetPortTraffic()
+		}
+		s.trafficDone = make(chan string)
+		go s.simulateTraffic(s.trafficDone)
+	} else if len(flows) == 0 && s.trafficDone != nil {
+		close(s.trafficDone)
+		s.trafficDone = nil
+	}
+	return nil
+}
+
+// GetTrafficMatrix returns the traffic matrix which drives the port counters of all devices
+func (s *Simulation) GetTrafficMatrix() []*TrafficFlow {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.trafficFlows
+}
+
+// HasTrafficMatrix returns true if the port counters are driven by a traffic matrix
+func (s *Simulation) HasTrafficMatrix() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.trafficFlows) > 0
+}
+
+// Periodically moves the traffic of all flows across the fabric, until done
+func (s *Simulation) simulateTraffic(done chan string) {
+	last := time.Now()
+	for {
+		select {
+		case <-time.After(trafficInterval):
+			now := time.Now()
+			s.moveTraffic(now.Sub(last))
+			last = now
+		case <-done:
+			return
+		}
+	}
+}
+
+// Moves the traffic of all flows for the given time period across the fabric and updates the port counters
+func (s *Simulation) moveTraffic(elapsed time.Duration) {
+	traffic := make(map[simapi.PortID]*portTraffic)
+	account := func(portID simapi.PortID, bytes uint64, pkts uint64, in bool) {
+		pt, ok := traffic[portID]
+		if !ok {
+			pt = &portTraffic{}
+			traffic[portID] = pt
+		}
+		if in {
+			pt.inBytes += bytes
+			pt.inPkts += pkts
+		} else {
+			pt.outBytes += bytes
+			pt.outPkts += pkts
+		}
+	}
+
+	adjacency := s.getTrafficAdjacency()
+	for _, flow := range s.GetTrafficMatrix() {
+		srcPort := s.getTrafficPort(flow.Src)
+		dstPort := s.getTrafficPort(flow.Dst)
+		if srcPort == "" || dstPort == "" {
+			continue
+		}
+		path, ok := findPath(adjacency, srcPort, dstPort)
+		if !ok {
+			log.Debugf("No path for traffic from %s to %s", flow.Src, flow.Dst)
+			continue
+		}
+
+		bytes := uint64(float64(flow.Rate) / 8 * elapsed.Seconds())
+		pkts := bytes / flow.PacketSize
+		account(srcPort, bytes, pkts, true)
+		for _, link := range path {
+			account(link.SrcID, bytes, pkts, false)
+			account(link.TgtID, bytes, pkts, true)
+		}
+		account(dstPort, bytes, pkts, false)
+	}
+
+	for portID, pt := range traffic {
+		if deviceSim, err := s.GetDeviceSimulatorForPort(portID); err == nil {
+			deviceSim.addPortTraffic(portID, pt)
+		}
+	}
+}
+
+// Returns the device port to which the first usable NIC of the specified host is attached; empty if none
+func (s *Simulation) getTrafficPort(id simapi.HostID) simapi.PortID {
+	hostSim, err := s.GetHostSimulator(id)
+	if err != nil {
+		return ""
+	}
+	for _, nic := range hostSim.Host.Interfaces {
+		if s.isPortEnabled(nic.ID) && hostSim.IsNetworkInterfaceUp(nic) {
+			return nic.ID
+		}
+	}
+	return ""
+}
+
+// Returns true if the specified device port exists and is enabled
+func (s *Simulation) isPortEnabled(portID simapi.PortID) bool {
+	deviceSim, err := s.GetDeviceSimulatorForPort(portID)
+	if err != nil {
+		return false
+	}
+	deviceSim.lock.RLock()
+	defer deviceSim.lock.RUnlock()
+	port, ok := deviceSim.Ports[portID]
+	return ok && port.Enabled
+}
+
+// Returns the links usable for carrying traffic, grouped by their source device and sorted by their IDs
+func (s *Simulation) getTrafficAdjacency() map[simapi.DeviceID][]*simapi.Link {
+	adjacency := make(map[simapi.DeviceID][]*simapi.Link)
+	for _, linkSim := range s.GetLinkSimulators() {
+		link := linkSim.Link
+		if isExternalLink(link) || linkSim.IsDisabled() || !s.isPortEnabled(link.SrcID) || !s.isPortEnabled(link.TgtID) {
+			continue
+		}
+		if srcID, err := ExtractDeviceID(link.SrcID); err == nil {
+			adjacency[srcID] = append(adjacency[srcID], link)
+		}
+	}
+	for _, links := range adjacency {
+		sort.Slice(links, func(i, j int) bool { return links[i].ID < links[j].ID })
+	}
+	return adjacency
+}
+
+// Finds the shortest path of links leading from the device of the source port to the device of the destination
+// port; returns false if there is no such path
+func findPath(adjacency map[simapi.DeviceID][]*simapi.Link, srcPort simapi.PortID, dstPort simapi.PortID) ([]*simapi.Link, bool) {
+	srcID, err := ExtractDeviceID(srcPort)
+	if err != nil {
+		return nil, false
+	}
+	dstID, err := ExtractDeviceID(dstPort)
+	if err != nil {
+		return nil, false
+	}
+	return findDevicePath(adjacency, srcID, dstID)
+}
+
+// Finds the shortest path of links leading from the source device to the destination device; returns false if
+// there is no such path
+func findDevicePath(adjacency map[simapi.DeviceID][]*simapi.Link, srcID simapi.DeviceID, dstID simapi.DeviceID) ([]*simapi.Link, bool) {
+	// Breadth-first search, remembering the link via which each device was reached
+	via := map[simapi.DeviceID]*simapi.Link{srcID: nil}
+	queue := []simapi.DeviceID{srcID}
+	for len(queue) > 0 && queue[0] != dstID {
+		id := queue[0]
+		queue = queue[1:]
+		for _, link := range adjacency[id] {
+			tgtID, err := ExtractDeviceID(link.TgtID)
+			if _, seen := via[tgtID]; err != nil || seen {
+				continue
+			}
+			via[tgtID] = link
+			queue = append(queue, tgtID)
+		}
+	}
+	if _, ok := via[dstID]; !ok {
+		return nil, false
+	}
+
+	path := make([]*simapi.Link, 0)
+	for id := dstID; via[id] != nil; {
+		link := via[id]
+		path = append([]*simapi.Link{link}, path...)
+		id, _ = ExtractDeviceID(link.SrcID)
+	}
+	return path, true
+}
+
+// Resets the traffic counters of all ports
+func (ds *DeviceSimulator) resetPortTraffic() {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	config.ResetPortTraffic(ds.config)
+}
+
+// Adds the given traffic to the counters of the specified port
+func (ds *DeviceSimulator) addPortTraffic(portID simapi.PortID, pt *portTraffic) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	port, ok := ds.Ports[portID]
+	if !ok {
+		return
+	}
+	ds.sendUpdates(config.AddPortTraffic(ds.config, port.Name, pt.inBytes, pt.inPkts, pt.outBytes, pt.outPkts))
+}
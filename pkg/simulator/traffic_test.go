@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewTrafficFlow(t *testing.T) {
+	flow, err := NewTrafficFlow("h1", "h2", "10Mbps", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10000000), flow.Rate)
+	assert.Equal(t, uint64(defaultPacketSize), flow.PacketSize)
+
+	flow, err = NewTrafficFlow("h1", "h2", "1.5Gbps", 1500)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1500000000), flow.Rate)
+	assert.Equal(t, uint64(1500), flow.PacketSize)
+
+	flow, err = NewTrafficFlow("h1", "h2", "64000", 64)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(64000), flow.Rate)
+
+	_, err = NewTrafficFlow("h1", "h2", "fast", 0)
+	assert.Error(t, err)
+	_, err = NewTrafficFlow("h1", "h2", "-1Mbps", 0)
+	assert.Error(t, err)
+	_, err = NewTrafficFlow("h1", "h2", "1Mbps", -1)
+	assert.Error(t, err)
+}
+
+func TestTrafficMatrix(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	addTestDevices(t, core, topology)
+	addTestLinks(t, core, topology)
+	for _, hd := range topology.Hosts {
+		_, err := core.AddHostSimulator(topo.ConstructHost(hd), nil)
+		assert.NoError(t, err)
+	}
+
+	flow, err := NewTrafficFlow("h111", "h211", "8Mbps", 1000)
+	assert.NoError(t, err)
+	assert.Error(t, core.SetTrafficMatrix([]*TrafficFlow{{Src: "h111", Dst: "foo"}}))
+	assert.NoError(t, core.SetTrafficMatrix([]*TrafficFlow{flow}))
+	assert.True(t, core.HasTrafficMatrix())
+	defer func() { _ = core.SetTrafficMatrix(nil) }()
+
+	counter := func(portID simapi.PortID, name string) uint64 {
+		ds, err := core.GetDeviceSimulatorForPort(portID)
+		assert.NoError(t, err)
+		path := fmt.Sprintf("interfaces/interface[name=%s]/state/counters/%s", ds.Ports[portID].Name, name)
+		ds.lock.RLock()
+		defer ds.lock.RUnlock()
+		return ds.config.GetPath(path).Value().GetUintVal()
+	}
+
+	core.moveTraffic(time.Second)
+
+	// One second at 8Mbps amounts to 1MB in 1000 packets entering the source leaf and leaving the destination leaf
+	srcPort := topology.Hosts[0].NICs[0].Port
+	assert.Equal(t, uint64(1000000), counter(simapi.PortID(srcPort), "in-octets"))
+	assert.Equal(t, uint64(1000), counter(simapi.PortID(srcPort), "in-unicast-pkts"))
+
+	// Whatever leaves one end of a link must enter the other end
+	total := uint64(0)
+	for _, linkSim := range core.GetLinkSimulators() {
+		out := counter(linkSim.Link.SrcID, "out-octets")
+		assert.Equal(t, out, counter(linkSim.Link.TgtID, "in-octets"))
+		total += out
+	}
+	// Path from leaf11 to leaf21 goes via one of the spines
+	assert.Equal(t, uint64(2000000), total)
+
+	// Traffic does not move when the source port is down
+	srcDevice, err := core.GetDeviceSimulatorForPort(simapi.PortID(srcPort))
+	assert.NoError(t, err)
+	assert.NoError(t, srcDevice.DisablePort(simapi.PortID(srcPort), simapi.StopMode_CHAOTIC_STOP))
+	core.moveTraffic(time.Second)
+	assert.Equal(t, uint64(1000000), counter(simapi.PortID(srcPort), "in-octets"))
+
+	assert.NoError(t, core.SetTrafficMatrix(nil))
+	assert.False(t, core.HasTrafficMatrix())
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	utils "github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Alarm thresholds of the transceiver DOM values, past which the port loses its link
+const (
+	txPowerLowAlarm      = -8.0
+	rxPowerLowAlarm      = -14.0
+	temperatureHighAlarm = 75.0
+)
+
+// TransceiverDrift describes the amounts by which the transceiver DOM values drift
+type TransceiverDrift struct {
+	TxPower     float64
+	RxPower     float64
+	Temperature float64
+	Bias        float64
+}
+
+// UnplugTransceiver unplugs the transceiver of the specified port, taking the port oper-DOWN
+func (s *Simulation) UnplugTransceiver(id simapi.PortID) error {
+	return s.updateTransceiver(id, func(t *config.Transceiver) { t.Present = false })
+}
+
+// PlugTransceiver plugs the transceiver of the specified port back in, bringing the port oper-UP unless its
+// DOM values are past their alarm thresholds
+func (s *Simulation) PlugTransceiver(id simapi.PortID) error {
+	return s.updateTransceiver(id, func(t *config.Transceiver) { t.Present = true })
+}
+
+// DriftTransceiver drifts the DOM values of the transceiver of the specified port by the given amounts; the port
+// goes oper-DOWN when the values move past their alarm thresholds and back oper-UP when they return
+func (s *Simulation) DriftTransceiver(id simapi.PortID, drift *TransceiverDrift) error {
+	return s.updateTransceiver(id, func(t *config.Transceiver) {
+		t.TxPower += drift.TxPower
+		t.RxPower += drift.RxPower
+		t.Temperature += drift.Temperature
+		t.Bias += drift.Bias
+	})
+}
+
+// GetTransceiver returns the state of the transceiver of the specified port
+func (s *Simulation) GetTransceiver(id simapi.PortID) (*config.Transceiver, error) {
+	deviceSim, err := s.GetDeviceSimulatorForPort(id)
+	if err != nil {
+		return nil, err
+	}
+	return deviceSim.GetTransceiver(id)
+}
+
+func (s *Simulation) updateTransceiver(id simapi.PortID, update func(t *config.Transceiver)) error {
+	deviceSim, err := s.GetDeviceSimulatorForPort(id)
+	if err != nil {
+		return err
+	}
+	return deviceSim.updateTransceiver(id, update)
+}
+
+// GetTransceiver returns the state of the transceiver of the specified port
+func (ds *DeviceSimulator) GetTransceiver(id simapi.PortID) (*config.Transceiver, error) {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	t, ok := ds.transceivers[physicalPortID(id)]
+	if !ok {
+		return nil, errors.NewNotFound("port %s not found", id)
+	}
+	state := *t
+	return &state, nil
+}
+
+// Applies the given update to the transceiver of the specified port, notifies subscribers of the new state and
+// changes the status of the port, or its breakout child ports, if the transceiver health changed
+func (ds *DeviceSimulator) updateTransceiver(id simapi.PortID, update func(t *config.Transceiver)) error {
+	id = physicalPortID(id)
+	ds.lock.Lock()
+	t, ok := ds.transceivers[id]
+	if !ok {
+		ds.lock.Unlock()
+		return errors.NewNotFound("port %s not found", id)
+	}
+	wasHealthy := isTransceiverHealthy(t)
+	update(t)
+	healthy := isTransceiverHealthy(t)
+	log.Infof("Device %s: Transceiver of port %s is now %+v", ds.Device.ID, id, *t)
+
+	nodes := config.UpdateTransceiver(ds.config, ds.physicalPorts[id].Name, t)
+	updates := make([]*gnmi.Update, 0, len(nodes))
+	for _, node := range nodes {
+		updates = append(updates, &gnmi.Update{Path: utils.ToPath(node.Path()), Val: node.Value()})
+	}
+	ds.GNMIConfigurable.SendToAllResponders(&gnmi.SubscribeResponse{
+		Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{Update: updates}},
+	})
+	portIDs := ds.getLogicalPortIDs(id)
+	ds.lock.Unlock()
+
+	if healthy != wasHealthy {
+		status := simapi.LinkStatus_LINK_DOWN
+		if healthy {
+			status = simapi.LinkStatus_LINK_UP
+		}
+		for _, portID := range portIDs {
+			if err := ds.setPortStatus(portID, status); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns true if the transceiver is present and its DOM values are within their alarm thresholds
+func isTransceiverHealthy(t *config.Transceiver) bool {
+	return t.Present && t.TxPower >= txPowerLowAlarm && t.RxPower >= rxPowerLowAlarm && t.Temperature <= temperatureHighAlarm
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"github.com/onosproject/fabric-sim/pkg/topo"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTransceiver(t *testing.T) {
+	core := NewSimulation()
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, core, topology)
+
+	portID := simapi.PortID("spine1/1")
+	value := func(path string) string {
+		return ds.config.GetPath("components/component[name=transceiver-1]/" + path).Value().GetStringVal()
+	}
+	assert.Equal(t, "transceiver-1",
+		ds.config.GetPath("interfaces/interface[name=1]/state/transceiver").Value().GetStringVal())
+	assert.Equal(t, "TRANSCEIVER", value("state/type"))
+	assert.Equal(t, "PRESENT", value("transceiver/state/present"))
+	assert.Equal(t, "QSFP28", value("transceiver/state/form-factor"))
+	assert.Equal(t, -2.0, ds.config.GetPath("components/component[name=transceiver-1]/transceiver/physical-channels/channel[index=0]/state/input-power/instant").Value().GetDoubleVal())
+
+	// Unplugged transceiver takes the port down
+	assert.NoError(t, core.UnplugTransceiver(portID))
+	assert.Equal(t, "NOT_PRESENT", value("transceiver/state/present"))
+	assert.False(t, ds.Ports[portID].Enabled)
+	assert.NoError(t, core.PlugTransceiver(portID))
+	assert.True(t, ds.Ports[portID].Enabled)
+
+	// Input power drifting past its alarm threshold takes the port down, until it recovers
+	assert.NoError(t, core.DriftTransceiver(portID, &TransceiverDrift{RxPower: -5}))
+	assert.True(t, ds.Ports[portID].Enabled)
+	assert.NoError(t, core.DriftTransceiver(portID, &TransceiverDrift{RxPower: -10, Temperature: 10}))
+	assert.False(t, ds.Ports[portID].Enabled)
+	state, err := core.GetTransceiver(portID)
+	assert.NoError(t, err)
+	assert.Equal(t, -17.0, state.RxPower)
+	assert.Equal(t, 45.0, state.Temperature)
+	assert.NoError(t, core.DriftTransceiver(portID, &TransceiverDrift{RxPower: 15}))
+	assert.True(t, ds.Ports[portID].Enabled)
+
+	assert.Error(t, core.UnplugTransceiver("spine1/99"))
+	_, err = core.GetTransceiver("foo/1")
+	assert.Error(t, err)
+
+	// Form factor follows the speed regardless of its format
+	device := topo.ConstructDevice(topology.Devices[1])
+	for _, port := range device.Ports {
+		port.Speed = "SPEED_10GB"
+	}
+	ds, err = core.AddDeviceSimulator(device, &testAgent{})
+	assert.NoError(t, err)
+	name := device.Ports[0].Name
+	assert.Equal(t, "SFP_PLUS", ds.config.GetPath("components/component[name=transceiver-"+name+"]/transceiver/state/form-factor").Value().GetStringVal())
+}
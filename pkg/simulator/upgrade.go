@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	"github.com/openconfig/gnoi/types"
+	"hash"
+	"path"
+)
+
+// UpgradeStatus describes the software upgrade of a device, i.e. the running software version, the version pending
+// activation on the next reboot and, if the last activation failed, the version which failed to activate and why
+type UpgradeStatus struct {
+	RunningVersion string
+	PendingVersion string
+	FailedVersion  string
+	FailureReason  string
+}
+
+// SetUpgradeFailure injects a failure with the given reason into the next software activation of the specified
+// device; empty reason lets the activation succeed
+func (s *Simulation) SetUpgradeFailure(deviceID simapi.DeviceID, reason string) error {
+	ds, err := s.GetDeviceSimulator(deviceID)
+	if err != nil {
+		return err
+	}
+	ds.SetUpgradeFailure(reason)
+	return nil
+}
+
+// SetUpgradeFailure injects a failure with the given reason into the next software activation of the device; empty
+// reason lets the activation succeed
+func (ds *DeviceSimulator) SetUpgradeFailure(reason string) {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	log.Infof("Device %s: Setting upgrade failure %q", ds.Device.ID, reason)
+	ds.upgradeFailure = reason
+}
+
+// SetPackage verifies the given contents of a software package against the given hash, stores the package on the
+// virtual filesystem under its absolute file name and installs the software; if the package is to be activated, records its version, or lacking
+// one its file name, as pending activation on the next reboot
+func (ds *DeviceSimulator) SetPackage(pkg *gnoiapi.Package, contents []byte, hash *types.HashType) error {
+	if pkg.RemoteDownload != nil {
+		return errors.NewNotSupported("remote download of packages not supported")
+	}
+	if len(contents) == 0 {
+		return errors.NewInvalid("package %s has no contents", pkg.Filename)
+	}
+	if err := verifyHash(contents, hash); err != nil {
+		return err
+	}
+	filePath, err := cleanFilePath(pkg.Filename)
+	if err != nil {
+		return err
+	}
+	version := pkg.Version
+	if len(version) == 0 {
+		version = path.Base(filePath)
+	}
+	if len(version) == 0 || version == "/" {
+		return errors.NewInvalid("package has neither version nor file name")
+	}
+
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	if err = ds.checkFilePath(filePath); err != nil {
+		return err
+	}
+	ds.putFile(filePath, 0644, contents)
+	ds.installedSoftware[version] = true
+	if !pkg.Activate {
+		log.Infof("Device %s: Received package %s (%s)", ds.Device.ID, pkg.Filename, version)
+		return nil
+	}
+	log.Infof("Device %s: Received package %s (%s) for activation on next reboot", ds.Device.ID, pkg.Filename, version)
+	ds.setPendingSoftware(version)
+	return nil
+}
+
+// InstallSoftware installs the software with the given version and image contents on the device, so that it can be
+// activated later
+func (ds *DeviceSimulator) InstallSoftware(version string, contents []byte) error {
+	if len(version) == 0 {
+		return errors.NewInvalid("software version missing")
+	}
+	if len(contents) == 0 {
+		return errors.NewInvalid("software %s has no contents", version)
+	}
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	log.Infof("Device %s: Installed software %s (%d bytes)", ds.Device.ID, version, len(contents))
+	ds.installedSoftware[version] = true
+	return nil
+}
+
+// IsSoftwareInstalled returns true if the software with the given version is installed on the device
+func (ds *DeviceSimulator) IsSoftwareInstalled(version string) bool {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	return ds.installedSoftware[version]
+}
+
+// ActivateSoftware schedules the activation of the installed software with the given version on the next reboot of
+// the device and, unless asked not to, reboots the device right away; activating the running software cancels any
+// pending activation
+func (ds *DeviceSimulator) ActivateSoftware(version string, reboot bool) error {
+	ds.lock.Lock()
+	if !ds.installedSoftware[version] {
+		ds.lock.Unlock()
+		return errors.NewNotFound("software %s not installed", version)
+	}
+	if version == config.GetSoftwareVersion(ds.config) {
+		log.Infof("Device %s: Software %s already running", ds.Device.ID, version)
+		ds.setPendingSoftware("")
+		ds.lock.Unlock()
+		return nil
+	}
+	log.Infof("Device %s: Activating software %s on next reboot", ds.Device.ID, version)
+	ds.setPendingSoftware(version)
+	ds.lock.Unlock()
+
+	if !reboot {
+		return nil
+	}
+	if err := ds.Reboot(gnoiapi.RebootMethod_COLD, 0, "activating software "+version); err != nil && !errors.IsConflict(err) {
+		return err
+	}
+	return nil
+}
+
+// Sets the version of the software pending activation, replacing any software pending before; empty version cancels
+// the pending activation; must be called with the lock held
+func (ds *DeviceSimulator) setPendingSoftware(version string) {
+	ds.upgrade.PendingVersion = version
+	ds.upgrade.FailedVersion, ds.upgrade.FailureReason = "", ""
+	ds.setStandbySoftware(version, "")
+}
+
+// Sets the standby software version along with the reason why its activation failed, if it did, and notifies the
+// subscribers; empty version removes the standby software component, which the subscribers are notified of as
+// a delete; must be called with the lock held
+func (ds *DeviceSimulator) setStandbySoftware(version string, failure string) {
+	component := fmt.Sprintf("components/component[name=%s]", config.StandbyOSName)
+	existed := ds.config.GetPath(component) != nil
+	ds.sendUpdates(config.SetStandbySoftware(ds.config, version, failure))
+	if len(version) == 0 && existed {
+		ds.sendDeletes(component)
+	}
+}
+
+// GetUpgradeStatus returns the status of the software upgrade of the device
+func (ds *DeviceSimulator) GetUpgradeStatus() UpgradeStatus {
+	ds.lock.RLock()
+	defer ds.lock.RUnlock()
+	status := ds.upgrade
+	status.RunningVersion = config.GetSoftwareVersion(ds.config)
+	return status
+}
+
+// Activates the software pending activation, if any, unless a failure has been injected into the activation; must
+// be called while the device reboots
+func (ds *DeviceSimulator) activateSoftware() {
+	ds.lock.Lock()
+	defer ds.lock.Unlock()
+	version := ds.upgrade.PendingVersion
+	if len(version) == 0 {
+		return
+	}
+	ds.upgrade.PendingVersion = ""
+	if len(ds.upgradeFailure) > 0 {
+		log.Warnf("Device %s: Activation of software %s failed: %s", ds.Device.ID, version, ds.upgradeFailure)
+		ds.upgrade.FailedVersion, ds.upgrade.FailureReason = version, ds.upgradeFailure
+		ds.upgradeFailure = ""
+		ds.setStandbySoftware(version, ds.upgrade.FailureReason)
+		return
+	}
+	log.Infof("Device %s: Activated software %s", ds.Device.ID, version)
+	ds.sendUpdates(config.SetSoftwareVersion(ds.config, version))
+	ds.setStandbySoftware("", "")
+}
+
+// Verifies the given contents against the given hash
+func verifyHash(contents []byte, hashType *types.HashType) error {
+	if hashType == nil {
+		return errors.NewInvalid("hash missing")
+	}
+	var h hash.Hash
+	switch hashType.Method {
+	case types.HashType_MD5:
+		h = md5.New()
+	case types.HashType_SHA256:
+		h = sha256.New()
+	case types.HashType_SHA512:
+		h = sha512.New()
+	default:
+		return errors.NewInvalid("hash method %s not supported", hashType.Method)
+	}
+	h.Write(contents)
+	if !bytes.Equal(h.Sum(nil), hashType.Hash) {
+		return errors.NewInvalid("%s hash mismatch", hashType.Method)
+	}
+	return nil
+}
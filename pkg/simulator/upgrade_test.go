@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"crypto/sha256"
+	"github.com/onosproject/fabric-sim/pkg/simulator/config"
+	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/gnmiutils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	gnoiapi "github.com/openconfig/gnoi/system"
+	"github.com/openconfig/gnoi/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSoftwareUpgrade(t *testing.T) {
+	simulation := NewSimulation()
+	assert.NoError(t, simulation.SetRebootDowntime(100*time.Millisecond))
+	topology := loadTestTopology(t)
+	ds := addTestDevice(t, simulation, topology)
+	assert.NoError(t, ds.Start(simulation))
+	defer ds.Stop(simapi.StopMode_ORDERLY_STOP)
+
+	contents := []byte("fabric-sim software image")
+	sum := sha256.Sum256(contents)
+	hash := &types.HashType{Method: types.HashType_SHA256, Hash: sum[:]}
+	standbyStatus := func() string {
+		ds.lock.RLock()
+		defer ds.lock.RUnlock()
+		if n := ds.config.GetPath("components/component[name=os-standby]/state/oper-status"); n != nil {
+			return n.Value().GetStringVal()
+		}
+		return ""
+	}
+	reboot := func(count uint32) {
+		assert.NoError(t, ds.Reboot(gnoiapi.RebootMethod_COLD, 0, "upgrade"))
+		assert.Eventually(t, func() bool { return ds.RebootStatus().Count == count }, time.Second, 20*time.Millisecond)
+	}
+
+	err := ds.SetPackage(&gnoiapi.Package{Filename: "/tmp/os.bin", Version: "2.0.0", Activate: true},
+		contents, &types.HashType{Method: types.HashType_SHA256, Hash: []byte("bad")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Equal(t, "", ds.GetUpgradeStatus().PendingVersion)
+
+	// Packages must have an absolute file name
+	for _, filename := range []string{"os.bin", ""} {
+		err = ds.SetPackage(&gnoiapi.Package{Filename: filename, Version: "2.0.0", Activate: true}, contents, hash)
+		assert.True(t, errors.IsInvalid(err), filename)
+	}
+	assert.Equal(t, "", ds.GetUpgradeStatus().PendingVersion)
+
+	// Package activates on the next reboot, upon which the standby software component is deleted
+	ts := &testSubscriber{}
+	subscription := ds.NewGNMISubscription(ts.send, nil)
+	defer subscription.Close()
+	_, err = subscription.Process(&gnmi.SubscribeRequest{Request: &gnmi.SubscribeRequest_Subscribe{Subscribe: &gnmi.SubscriptionList{
+		Mode: gnmi.SubscriptionList_STREAM, UpdatesOnly: true, Subscription: []*gnmi.Subscription{{
+			Path: gnmiutils.ToPath("components/component[name=os-standby]"), Mode: gnmi.SubscriptionMode_ON_CHANGE}},
+	}}})
+	assert.NoError(t, err)
+	deleted := func() bool {
+		ts.lock.Lock()
+		defer ts.lock.Unlock()
+		for _, response := range ts.responses {
+			for _, path := range response.GetUpdate().GetDelete() {
+				if gnmiutils.ToString(path) == "components/component[name=os-standby]/state/software-version" {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	assert.NoError(t, ds.SetPackage(&gnoiapi.Package{Filename: "/tmp/os.bin", Version: "2.0.0", Activate: true}, contents, hash))
+	status := ds.GetUpgradeStatus()
+	assert.Equal(t, config.DefaultSoftwareVersion, status.RunningVersion)
+	assert.Equal(t, "2.0.0", status.PendingVersion)
+	assert.Equal(t, "INACTIVE", standbyStatus())
+	reboot(1)
+	status = ds.GetUpgradeStatus()
+	assert.Equal(t, "2.0.0", status.RunningVersion)
+	assert.Equal(t, "", status.PendingVersion)
+	assert.Equal(t, "", standbyStatus())
+	assert.Eventually(t, deleted, 500*time.Millisecond, 20*time.Millisecond)
+
+	// Injected failure keeps the device on its running software
+	assert.NoError(t, ds.SetPackage(&gnoiapi.Package{Filename: "/tmp/os-3.0.0.bin", Activate: true}, contents, hash))
+	assert.Equal(t, "os-3.0.0.bin", ds.GetUpgradeStatus().PendingVersion)
+	ds.SetUpgradeFailure("corrupt image")
+	reboot(2)
+	status = ds.GetUpgradeStatus()
+	assert.Equal(t, "2.0.0", status.RunningVersion)
+	assert.Equal(t, "os-3.0.0.bin", status.FailedVersion)
+	assert.Equal(t, "corrupt image", status.FailureReason)
+	assert.Equal(t, "DISABLED", standbyStatus())
+
+	// Installed software activates only once requested
+	assert.True(t, errors.IsNotFound(ds.ActivateSoftware("4.0.0", false)))
+	assert.False(t, ds.IsSoftwareInstalled("4.0.0"))
+	assert.NoError(t, ds.InstallSoftware("4.0.0", contents))
+	assert.True(t, ds.IsSoftwareInstalled("4.0.0"))
+	assert.Equal(t, "", ds.GetUpgradeStatus().PendingVersion)
+	ds.SetUpgradeFailure("")
+	assert.NoError(t, ds.ActivateSoftware("4.0.0", true))
+	assert.Eventually(t, func() bool { return ds.RebootStatus().Count == 3 }, time.Second, 20*time.Millisecond)
+	assert.Equal(t, "4.0.0", ds.GetUpgradeStatus().RunningVersion)
+}
@@ -53,11 +53,21 @@ func GenerateAccessFabric(fabric *AccessFabric) *Topology {
 		// Latch the min ports for host attachment
 		builder.minPort[sw1.ID] = builder.nextPort[sw1.ID]
 		builder.minPort[sw2.ID] = builder.nextPort[sw2.ID]
+		if len(fabric.LeafBreakout) > 0 {
+			builder.breakoutPorts(leaf1, fabric.LeafBreakout, topology)
+			builder.breakoutPorts(leaf2, fabric.LeafBreakout, topology)
+		}
 
 		// Finally, create the hosts and attach them to the leaf pairs
 		createRackHosts(pair, leaf1, leaf2, fabric.HostsPerPair, fabric.HostsHaveIPU, fabric.VMsPerIPU,
 			builder, topology, coord(2*pair, 2*fabric.LeafPairs, leafGap, -leafGap/2), hostsPerRow)
 	}
 
+	if fabric.HostsHaveLACP {
+		enableHostsLACP(topology)
+	}
+	if fabric.TrunksAsLAGs {
+		groupTrunksIntoLAGs(topology)
+	}
 	return topology
 }
@@ -27,6 +27,10 @@ func GenerateFixedFabric(fabric *FixedFabric) *Topology {
 	// Next generate two rack-pair fabrics and connect it to super-spines
 	createRackPairFabric(1, fabric, builder, topology)
 	createRackPairFabric(3, fabric, builder, topology)
+
+	if fabric.HostsHaveLACP {
+		enableHostsLACP(topology)
+	}
 	return topology
 }
 
@@ -10,6 +10,8 @@ import (
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/spf13/viper"
 	"os"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -28,15 +30,18 @@ type Recipe struct {
 
 // AccessFabric is a recipe for creating simulated access fabric with spines and paired leaves
 type AccessFabric struct {
-	Spines         int  `mapstructure:"spines" yaml:"spines"`
-	SpinePortCount int  `mapstructure:"spine_port_count" yaml:"spine_port_count"`
-	LeafPairs      int  `mapstructure:"leaf_pairs" yaml:"leaf_pairs"`
-	LeafPortCount  int  `mapstructure:"leaf_port_count" yaml:"leaf_port_count"`
-	SpineTrunk     int  `mapstructure:"spine_trunk" yaml:"spine_trunk"`
-	PairTrunk      int  `mapstructure:"pair_trunk" yaml:"pair_trunk"`
-	HostsPerPair   int  `mapstructure:"hosts_per_pair" yaml:"hosts_per_pair"`
-	HostsHaveIPU   bool `mapstructure:"hosts_have_ipu" yaml:"hosts_have_ipu_leaf"`
-	VMsPerIPU      int  `mapstructure:"vms_per_ipu" yaml:"vms_per_ipu"`
+	Spines         int    `mapstructure:"spines" yaml:"spines"`
+	SpinePortCount int    `mapstructure:"spine_port_count" yaml:"spine_port_count"`
+	LeafPairs      int    `mapstructure:"leaf_pairs" yaml:"leaf_pairs"`
+	LeafPortCount  int    `mapstructure:"leaf_port_count" yaml:"leaf_port_count"`
+	SpineTrunk     int    `mapstructure:"spine_trunk" yaml:"spine_trunk"`
+	PairTrunk      int    `mapstructure:"pair_trunk" yaml:"pair_trunk"`
+	HostsPerPair   int    `mapstructure:"hosts_per_pair" yaml:"hosts_per_pair"`
+	HostsHaveIPU   bool   `mapstructure:"hosts_have_ipu" yaml:"hosts_have_ipu_leaf"`
+	VMsPerIPU      int    `mapstructure:"vms_per_ipu" yaml:"vms_per_ipu"`
+	HostsHaveLACP  bool   `mapstructure:"hosts_have_lacp" yaml:"hosts_have_lacp"`
+	TrunksAsLAGs   bool   `mapstructure:"trunks_as_lags" yaml:"trunks_as_lags"`
+	LeafBreakout   string `mapstructure:"leaf_breakout" yaml:"leaf_breakout"`
 }
 
 // PlainFabric is a recipe for creating simulated plain leaf-spine fabric with optional IPUs
@@ -66,7 +71,7 @@ type SuperSpineTier struct {
 
 // FixedFabric is a recipe for creating simulated 4 rack fabric with superspines
 type FixedFabric struct {
-	// Add any parametrization here, if needed
+	HostsHaveLACP bool `mapstructure:"hosts_have_lacp" yaml:"hosts_have_lacp"`
 }
 
 // GenerateTopology loads the specified topology recipe YAML file and uses the recipe to
@@ -157,18 +162,22 @@ func saveTopologyFile(topology *Topology, path string) error {
 
 // Builder hods state to assist generating various fabric topologies
 type Builder struct {
-	agentPort int32
-	nextPort  map[string]int
-	minPort   map[string]int
-	maxPort   map[string]int
+	agentPort   int32
+	nextPort    map[string]int
+	minPort     map[string]int
+	maxPort     map[string]int
+	breakouts   map[string]int
+	nextChannel map[string]int
 }
 
 // NewBuilder creates a new topology builder context
 func NewBuilder() *Builder {
 	return &Builder{
-		nextPort: make(map[string]int),
-		minPort:  make(map[string]int),
-		maxPort:  make(map[string]int),
+		nextPort:    make(map[string]int),
+		minPort:     make(map[string]int),
+		maxPort:     make(map[string]int),
+		breakouts:   make(map[string]int),
+		nextChannel: make(map[string]int),
 	}
 }
 
@@ -186,6 +195,17 @@ func (b *Builder) NextDevicePortID(deviceID string) string {
 		portNumber = 1
 	}
 	portID := fmt.Sprintf("%s/%d", deviceID, portNumber)
+
+	// Hand out the child ports of broken out ports one by one, before moving to the next port
+	if count, ok := b.breakouts[deviceID]; ok && portNumber >= b.minPort[deviceID] {
+		channel := b.nextChannel[deviceID] + 1
+		portID = fmt.Sprintf("%s:%d", portID, channel)
+		if channel < count {
+			b.nextChannel[deviceID] = channel
+			return portID
+		}
+		b.nextChannel[deviceID] = 0
+	}
 	b.nextPort[deviceID] = portNumber + 1
 
 	// Wrap around to the min port range
@@ -195,6 +215,27 @@ func (b *Builder) NextDevicePortID(deviceID string) string {
 	return portID
 }
 
+// Breaks out all the ports of the specified device, starting with its min port, per the given breakout mode,
+// e.g. 4x25G; subsequently reserved port IDs refer to the child ports
+func (b *Builder) breakoutPorts(deviceID string, mode string, topology *Topology) {
+	count, err := strconv.Atoi(strings.Split(mode, "x")[0])
+	if err != nil || count < 2 {
+		log.Warnf("Invalid breakout mode %s", mode)
+		return
+	}
+	b.breakouts[deviceID] = count
+	for i := range topology.Devices {
+		if topology.Devices[i].ID != deviceID {
+			continue
+		}
+		for j := range topology.Devices[i].Ports {
+			if port := &topology.Devices[i].Ports[j]; int(port.Number) >= b.minPort[deviceID] {
+				port.Breakout = mode
+			}
+		}
+	}
+}
+
 // Create a switch with the specified number of ports
 func createSwitch(deviceID string, portCount int, builder *Builder, topology *Topology, pos *GridPosition) Device {
 	device := Device{
@@ -291,6 +332,60 @@ func createRackHost(rackID int, hostID int, leaf1 string, leaf2 string, hostsHav
 	return &host
 }
 
+// Enables the LACP actor on all the multi-homed hosts of the given topology
+func enableHostsLACP(topology *Topology) {
+	for i := range topology.Hosts {
+		host := &topology.Hosts[i]
+		if len(host.NICs) < 2 {
+			continue
+		}
+		if host.Behavior == nil {
+			host.Behavior = &HostBehavior{}
+		}
+		host.Behavior.LACP = true
+	}
+}
+
+// Groups the ports of each trunk of two or more links in the given topology into a pair of LAGs, one at each end
+func groupTrunksIntoLAGs(topology *Topology) {
+	lags := make(map[string]*LAG)
+	names := make([]string, 0)
+	addMember := func(deviceID string, peerID string, portID string) {
+		number, err := strconv.ParseUint(strings.TrimPrefix(portID, deviceID+"/"), 10, 32)
+		if err != nil {
+			return
+		}
+		name := deviceID + "/lag-" + peerID
+		lag, ok := lags[name]
+		if !ok {
+			lag = &LAG{Name: "lag-" + peerID}
+			lags[name] = lag
+			names = append(names, name)
+		}
+		lag.Members = append(lag.Members, uint32(number))
+	}
+
+	for _, link := range topology.Links {
+		srcID := strings.Split(link.SrcPortID, "/")[0]
+		tgtID := strings.Split(link.TgtPortID, "/")[0]
+		addMember(srcID, tgtID, link.SrcPortID)
+		addMember(tgtID, srcID, link.TgtPortID)
+	}
+
+	for _, name := range names {
+		lag := lags[name]
+		if len(lag.Members) < 2 {
+			continue
+		}
+		deviceID := strings.Split(name, "/")[0]
+		for i := range topology.Devices {
+			if topology.Devices[i].ID == deviceID {
+				topology.Devices[i].LAGs = append(topology.Devices[i].LAGs, *lag)
+			}
+		}
+	}
+}
+
 func createServerIPUAndVMs(rackID int, hostID int, leaf1 string, leaf2 string, vmsPerIPU int,
 	builder *Builder, topology *Topology, pos *GridPosition) []NIC {
 	ipuID := fmt.Sprintf("ipu%02d%02d", rackID, hostID)
@@ -113,6 +113,100 @@ func TestGenerateAccessFabric(t *testing.T) {
 `)
 }
 
+func TestGenerateAccessFabricWithLACP(t *testing.T) {
+	topo := GenerateAccessFabric(&AccessFabric{
+		Spines:         2,
+		SpinePortCount: 32,
+		LeafPairs:      1,
+		LeafPortCount:  32,
+		SpineTrunk:     1,
+		PairTrunk:      1,
+		HostsPerPair:   4,
+		HostsHaveLACP:  true,
+	})
+	assert.Len(t, topo.Hosts, 4)
+	for _, host := range topo.Hosts {
+		assert.Len(t, host.NICs, 2)
+		assert.NotNil(t, host.Behavior)
+		assert.True(t, host.Behavior.LACP)
+	}
+
+	testFromRecipe(t, "access_lacp", `access_fabric:
+  spines: 2
+  spine_port_count: 32
+  leaf_pairs: 1
+  leaf_port_count: 32
+  spine_trunk: 1
+  pair_trunk: 1
+  hosts_per_pair: 4
+  hosts_have_lacp: true
+`)
+}
+
+func TestGenerateAccessFabricWithLAGs(t *testing.T) {
+	topo := GenerateAccessFabric(&AccessFabric{
+		Spines:         2,
+		SpinePortCount: 32,
+		LeafPairs:      1,
+		LeafPortCount:  32,
+		SpineTrunk:     2,
+		PairTrunk:      1,
+		HostsPerPair:   4,
+		TrunksAsLAGs:   true,
+	})
+	for _, device := range topo.Devices {
+		// Each spine aggregates trunks to both leaves; each leaf aggregates trunks to both spines, but not its pair
+		assert.Len(t, device.LAGs, 2)
+		for _, lag := range device.LAGs {
+			assert.Len(t, lag.Members, 2)
+		}
+	}
+	assert.Equal(t, "lag-leaf11", topo.Devices[0].LAGs[0].Name)
+	assert.Equal(t, []uint32{1, 2}, topo.Devices[0].LAGs[0].Members)
+
+	testFromRecipe(t, "access_lags", `access_fabric:
+  spines: 2
+  spine_port_count: 32
+  leaf_pairs: 1
+  leaf_port_count: 32
+  spine_trunk: 2
+  pair_trunk: 1
+  hosts_per_pair: 4
+  trunks_as_lags: true
+`)
+}
+
+func TestGenerateAccessFabricWithBreakout(t *testing.T) {
+	topo := GenerateAccessFabric(&AccessFabric{
+		Spines:         2,
+		SpinePortCount: 32,
+		LeafPairs:      1,
+		LeafPortCount:  32,
+		SpineTrunk:     1,
+		PairTrunk:      1,
+		HostsPerPair:   6,
+		LeafBreakout:   "4x25G",
+	})
+	// Leaf ports 1-3 connect to the spines and the pair leaf; the rest are broken out for the hosts
+	leaf := topo.Devices[2]
+	assert.Equal(t, "", leaf.Ports[2].Breakout)
+	assert.Equal(t, "4x25G", leaf.Ports[3].Breakout)
+	assert.Equal(t, "leaf11/4:1", topo.Hosts[0].NICs[0].Port)
+	assert.Equal(t, "leaf11/4:4", topo.Hosts[3].NICs[0].Port)
+	assert.Equal(t, "leaf11/5:2", topo.Hosts[5].NICs[0].Port)
+
+	testFromRecipe(t, "access_breakout", `access_fabric:
+  spines: 2
+  spine_port_count: 32
+  leaf_pairs: 1
+  leaf_port_count: 32
+  spine_trunk: 1
+  pair_trunk: 1
+  hosts_per_pair: 6
+  leaf_breakout: 4x25G
+`)
+}
+
 func TestGenerateFixedFabric(t *testing.T) {
 	topo := GenerateFixedFabric(&FixedFabric{})
 	assert.Len(t, topo.Devices, 2+4+4*2)
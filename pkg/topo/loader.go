@@ -7,12 +7,14 @@ package topo
 import (
 	"context"
 	"fmt"
+	"github.com/onosproject/fabric-sim/api/fabricsimext"
 	simapi "github.com/onosproject/onos-api/go/onos/fabricsim"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"google.golang.org/grpc"
 )
 
-// LoadTopology loads the specified YAML file and creates the prescribed simulated topology entities
-// using the fabric simulator API client.
+// LoadTopology loads the specified YAML file and creates the prescribed simulated topology entities, along with
+// their simulated behaviors, using the fabric simulator API client.
 func LoadTopology(conn *grpc.ClientConn, topologyPath string) error {
 	log.Infof("Loading topology from %s", topologyPath)
 	topology := &Topology{}
@@ -35,7 +37,13 @@ func LoadTopology(conn *grpc.ClientConn, topologyPath string) error {
 	if err := createHosts(conn, topology.Hosts); err != nil {
 		return err
 	}
-	return nil
+
+	if err := setTrafficMatrix(conn, topology.Traffic); err != nil {
+		return err
+	}
+
+	// Start flapping links only after the entire topology is in place
+	return flapLinks(conn, topology.Links)
 }
 
 // LoadTopologyFile loads the specified topology YAML file
@@ -47,9 +55,10 @@ func LoadTopologyFile(path string, topology *Topology) error {
 	return cfg.Unmarshal(topology)
 }
 
-// Create all simulated Devices
+// Create all simulated Devices, along with their port breakouts and LAGs
 func createDevices(conn *grpc.ClientConn, devices []Device) error {
 	deviceClient := simapi.NewDeviceServiceClient(conn)
+	deviceExtClient := fabricsimext.NewDeviceServiceClient(conn)
 	ctx := context.Background()
 	for _, dd := range devices {
 		device := ConstructDevice(dd)
@@ -58,6 +67,28 @@ func createDevices(conn *grpc.ClientConn, devices []Device) error {
 			return err
 		}
 
+		for _, pd := range dd.Ports {
+			if len(pd.Breakout) == 0 {
+				continue
+			}
+			id := fmt.Sprintf("%s/%d", dd.ID, pd.Number)
+			if _, err := deviceExtClient.BreakoutPort(ctx, &fabricsimext.BreakoutPortRequest{Id: id, Mode: pd.Breakout}); err != nil {
+				log.Errorf("Unable to break out simulated port: %+v", err)
+				return err
+			}
+		}
+
+		for _, lag := range dd.LAGs {
+			request, err := constructAddLAGRequest(dd, lag)
+			if err != nil {
+				return err
+			}
+			if _, err = deviceExtClient.AddLAG(ctx, request); err != nil {
+				log.Errorf("Unable to create simulated LAG: %+v", err)
+				return err
+			}
+		}
+
 		if !dd.Stopped {
 			if _, err := deviceClient.StartDevice(ctx, &simapi.StartDeviceRequest{ID: device.ID}); err != nil {
 				log.Errorf("Unable to start agent for simulated device: %+v", err)
@@ -98,22 +129,110 @@ func ConstructDevice(dd Device) *simapi.Device {
 	}
 }
 
-// Create all simulated links
+// ConstructLAGMembers creates the list of member port IDs of the specified LAG YAML descriptor of the given device
+func ConstructLAGMembers(dd Device, lag LAG) []simapi.PortID {
+	members := make([]simapi.PortID, 0, len(lag.Members))
+	for _, number := range lag.Members {
+		members = append(members, simapi.PortID(fmt.Sprintf("%s/%d", dd.ID, number)))
+	}
+	return members
+}
+
+// Constructs the request to add the specified LAG YAML descriptor to the given device
+func constructAddLAGRequest(dd Device, lag LAG) (*fabricsimext.AddLAGRequest, error) {
+	minLinks, err := toUint32("LAG minimum links", lag.MinLinks)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]string, 0, len(lag.Members))
+	for _, id := range ConstructLAGMembers(dd, lag) {
+		members = append(members, string(id))
+	}
+	return &fabricsimext.AddLAGRequest{Id: dd.ID, Name: lag.Name, Members: members, MinLinks: minLinks}, nil
+}
+
+// Create all simulated links, along with their impairments and faults
 func createLinks(conn *grpc.ClientConn, links []Link) error {
 	linkClient := simapi.NewLinkServiceClient(conn)
+	linkExtClient := fabricsimext.NewLinkServiceClient(conn)
 	ctx := context.Background()
 	for _, ld := range links {
+		impairment := constructLinkImpairment(ld.Impairment)
+		faults := constructPortFaults(ld.Faults)
 		link := ConstructLink(ld)
 		if _, err := linkClient.AddLink(ctx, &simapi.AddLinkRequest{Link: link}); err != nil {
 			log.Errorf("Unable to create simulated link: %+v", err)
 			return err
 		}
+		if err := setLinkBehavior(ctx, linkExtClient, link.ID, impairment, faults); err != nil {
+			return err
+		}
 		if !ld.Unidirectional {
-			reverselink := constructReverseLink(ld)
+			reverselink := ConstructReverseLink(ld)
 			if _, err := linkClient.AddLink(ctx, &simapi.AddLinkRequest{Link: reverselink}); err != nil {
 				log.Errorf("Unable to create simulated link: %+v", err)
 				return err
 			}
+			if err := setLinkBehavior(ctx, linkExtClient, reverselink.ID, impairment, faults); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Sets the given impairment and faults, if any, of the specified link
+func setLinkBehavior(ctx context.Context, client fabricsimext.LinkServiceClient, id simapi.LinkID,
+	impairment *fabricsimext.LinkImpairment, faults *fabricsimext.PortFaults) error {
+	if impairment != nil {
+		if _, err := client.SetLinkImpairment(ctx, &fabricsimext.SetLinkImpairmentRequest{Id: string(id), Impairment: impairment}); err != nil {
+			log.Errorf("Unable to impair simulated link: %+v", err)
+			return err
+		}
+	}
+	if faults != nil {
+		if _, err := client.SetLinkFaults(ctx, &fabricsimext.SetLinkFaultsRequest{Id: string(id), Faults: faults}); err != nil {
+			log.Errorf("Unable to inject faults into simulated link: %+v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Creates the link impairment from the given link impairment YAML descriptor; nil for no impairment
+func constructLinkImpairment(li *LinkImpairment) *fabricsimext.LinkImpairment {
+	if li == nil {
+		return nil
+	}
+	return &fabricsimext.LinkImpairment{Latency: li.Latency, Jitter: li.Jitter, Loss: li.Loss, Duplication: li.Duplication}
+}
+
+// Creates the port faults from the given port faults YAML descriptor; nil for no faults
+func constructPortFaults(pf *PortFaults) *fabricsimext.PortFaults {
+	if pf == nil {
+		return nil
+	}
+	return &fabricsimext.PortFaults{FcsErrors: pf.FCSErrors, InErrors: pf.InErrors, InDiscards: pf.InDiscards,
+		OutDiscards: pf.OutDiscards, DownThreshold: pf.DownThreshold}
+}
+
+// Make all links with a flap schedule flap
+func flapLinks(conn *grpc.ClientConn, links []Link) error {
+	linkExtClient := fabricsimext.NewLinkServiceClient(conn)
+	ctx := context.Background()
+	for _, ld := range links {
+		if ld.Flap == nil {
+			continue
+		}
+		count, err := toUint32("link flap count", ld.Flap.Count)
+		if err != nil {
+			return err
+		}
+		schedule := &fabricsimext.LinkFlapSchedule{Count: count, Period: ld.Flap.Period, Down: ld.Flap.Down, Delay: ld.Flap.Delay}
+		request := &fabricsimext.FlapLinksRequest{Ids: []string{string(ConstructLink(ld).ID)}, Schedule: schedule}
+		if _, err = linkExtClient.FlapLinks(ctx, request); err != nil {
+			log.Errorf("Unable to flap simulated link: %+v", err)
+			return err
 		}
 	}
 	return nil
@@ -131,7 +250,8 @@ func ConstructLink(ld Link) *simapi.Link {
 	}
 }
 
-func constructReverseLink(ld Link) *simapi.Link {
+// ConstructReverseLink creates a link in the reverse direction from the specified link YAML descriptor
+func ConstructReverseLink(ld Link) *simapi.Link {
 	srcID := simapi.PortID(ld.SrcPortID)
 	tgtID := simapi.PortID(ld.TgtPortID)
 	return &simapi.Link{
@@ -142,20 +262,83 @@ func constructReverseLink(ld Link) *simapi.Link {
 	}
 }
 
-// Create all simulated hosts
+// Create all simulated hosts, along with their behaviors and multicast groups
 func createHosts(conn *grpc.ClientConn, hosts []Host) error {
 	hostClient := simapi.NewHostServiceClient(conn)
+	hostExtClient := fabricsimext.NewHostServiceClient(conn)
 	ctx := context.Background()
 	for _, hd := range hosts {
+		behavior, err := constructHostBehavior(hd.Behavior)
+		if err != nil {
+			return err
+		}
 		host := ConstructHost(hd)
-		if _, err := hostClient.AddHost(ctx, &simapi.AddHostRequest{Host: host}); err != nil {
+		if _, err = hostClient.AddHost(ctx, &simapi.AddHostRequest{Host: host}); err != nil {
 			log.Errorf("Unable to create simulated host: %+v", err)
 			return err
 		}
+		if behavior != nil {
+			if _, err = hostExtClient.SetHostBehavior(ctx, &fabricsimext.SetHostBehaviorRequest{Id: hd.ID, Behavior: behavior}); err != nil {
+				log.Errorf("Unable to set behavior of simulated host: %+v", err)
+				return err
+			}
+		}
+		for _, group := range hd.Groups {
+			if _, err = hostExtClient.JoinMulticastGroup(ctx, &fabricsimext.JoinMulticastGroupRequest{Id: hd.ID, Group: group}); err != nil {
+				log.Errorf("Unable to join multicast group: %+v", err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Creates the host behavior from the given host behavior YAML descriptor; nil for the default behavior
+func constructHostBehavior(hb *HostBehavior) (*fabricsimext.HostBehavior, error) {
+	if hb == nil {
+		return nil, nil
+	}
+	burstSize, err := toUint32("host burst size", hb.BurstSize)
+	if err != nil {
+		return nil, err
+	}
+	igmpVersion, err := toUint32("IGMP version", hb.IGMPVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &fabricsimext.HostBehavior{Profile: hb.Profile, Interval: hb.Interval, BurstSize: burstSize,
+		ArpTargets: hb.ARPTargets, IgmpVersion: igmpVersion, Lacp: hb.LACP, LacpFastRate: hb.LACPFastRate}, nil
+}
+
+// Set the traffic matrix from the given traffic flow YAML descriptors, if any
+func setTrafficMatrix(conn *grpc.ClientConn, traffic []TrafficFlow) error {
+	if len(traffic) == 0 {
+		return nil
+	}
+	flows := make([]*fabricsimext.TrafficFlow, 0, len(traffic))
+	for _, fd := range traffic {
+		packetSize, err := toUint32("packet size", fd.PacketSize)
+		if err != nil {
+			return err
+		}
+		flows = append(flows, &fabricsimext.TrafficFlow{Src: fd.Src, Dst: fd.Dst, Rate: fd.Rate, PacketSize: packetSize})
+	}
+	client := fabricsimext.NewFabricSimulatorClient(conn)
+	if _, err := client.SetTrafficMatrix(context.Background(), &fabricsimext.SetTrafficMatrixRequest{Flows: flows}); err != nil {
+		log.Errorf("Unable to set traffic matrix: %+v", err)
+		return err
 	}
 	return nil
 }
 
+// Returns the given non-negative value of the named topology field as uint32
+func toUint32(name string, value int) (uint32, error) {
+	if value < 0 {
+		return 0, errors.NewInvalid("invalid %s %d", name, value)
+	}
+	return uint32(value), nil
+}
+
 // ConstructHost creates a host from the specified host YAML descriptor
 func ConstructHost(hd Host) *simapi.Host {
 	nics := make([]*simapi.NetworkInterface, 0, len(hd.NICs))
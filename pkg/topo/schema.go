@@ -15,9 +15,18 @@ var log = logging.GetLogger("topo")
 
 // Topology is a description of a simulated network topology
 type Topology struct {
-	Devices []Device `mapstructure:"Devices" yaml:"Devices"`
-	Links   []Link   `mapstructure:"links" yaml:"links"`
-	Hosts   []Host   `mapstructure:"hosts" yaml:"hosts"`
+	Devices []Device      `mapstructure:"Devices" yaml:"Devices"`
+	Links   []Link        `mapstructure:"links" yaml:"links"`
+	Hosts   []Host        `mapstructure:"hosts" yaml:"hosts"`
+	Traffic []TrafficFlow `mapstructure:"traffic" yaml:"traffic,omitempty"`
+}
+
+// TrafficFlow is a description of a steady flow of traffic between two hosts at the given rate, e.g. 10Mbps
+type TrafficFlow struct {
+	Src        string `mapstructure:"src" yaml:"src"`
+	Dst        string `mapstructure:"dst" yaml:"dst"`
+	Rate       string `mapstructure:"rate" yaml:"rate"`
+	PacketSize int    `mapstructure:"packet_size" yaml:"packet_size,omitempty"`
 }
 
 // Device is a description of a simulated device
@@ -28,28 +37,84 @@ type Device struct {
 	AgentPort int32         `mapstructure:"agent_port" yaml:"agent_port"`
 	Stopped   bool          `mapstructure:"stopped" yaml:"stopped"`
 	Ports     []Port        `mapstructure:"ports" yaml:"ports"`
+	LAGs      []LAG         `mapstructure:"lags" yaml:"lags,omitempty"`
 	Pos       *GridPosition `mapstructure:"pos" yaml:"pos"`
 }
 
-// Port is a description of a simulated port
+// LAG is a description of a link aggregation group of device ports, identified by their numbers
+type LAG struct {
+	Name     string   `mapstructure:"name" yaml:"name"`
+	Members  []uint32 `mapstructure:"members" yaml:"members"`
+	MinLinks int      `mapstructure:"min_links" yaml:"min_links,omitempty"`
+}
+
+// Port is a description of a simulated port; breakout, e.g. 4x25G, splits the port into the given number of
+// child ports of the given speed, identified by the port number and channel, e.g. leaf1/5:2
 type Port struct {
 	Number    uint32 `mapstructure:"number" yaml:"number"`
 	SDNNumber uint32 `mapstructure:"sdn_number" yaml:"sdn_number"`
 	Speed     string `mapstructure:"speed" yaml:"speed"`
+	Breakout  string `mapstructure:"breakout" yaml:"breakout,omitempty"`
 }
 
 // Link is a description of a simulated link
 type Link struct {
-	SrcPortID      string `mapstructure:"src" yaml:"src"`
-	TgtPortID      string `mapstructure:"tgt" yaml:"tgt"`
-	Unidirectional bool   `mapstructure:"unidirectional" yaml:"unidirectional"`
+	SrcPortID      string          `mapstructure:"src" yaml:"src"`
+	TgtPortID      string          `mapstructure:"tgt" yaml:"tgt"`
+	Unidirectional bool            `mapstructure:"unidirectional" yaml:"unidirectional"`
+	Impairment     *LinkImpairment `mapstructure:"impairment" yaml:"impairment,omitempty"`
+	Flap           *LinkFlap       `mapstructure:"flap" yaml:"flap,omitempty"`
+	Faults         *PortFaults     `mapstructure:"faults" yaml:"faults,omitempty"`
+}
+
+// PortFaults is a description of the rates, per second, at which errors and discards accrue on the receiving
+// ports of a simulated link, optionally taking the ports oper-DOWN past a threshold; applies to both directions
+// of a bidirectional link
+type PortFaults struct {
+	FCSErrors     float64 `mapstructure:"fcs_errors" yaml:"fcs_errors,omitempty"`
+	InErrors      float64 `mapstructure:"in_errors" yaml:"in_errors,omitempty"`
+	InDiscards    float64 `mapstructure:"in_discards" yaml:"in_discards,omitempty"`
+	OutDiscards   float64 `mapstructure:"out_discards" yaml:"out_discards,omitempty"`
+	DownThreshold uint64  `mapstructure:"down_threshold" yaml:"down_threshold,omitempty"`
+}
+
+// LinkFlap is a description of a schedule per which a simulated link goes down and back up, starting after
+// the topology is loaded
+type LinkFlap struct {
+	Count  int    `mapstructure:"count" yaml:"count"`
+	Period string `mapstructure:"period" yaml:"period,omitempty"`
+	Down   string `mapstructure:"down" yaml:"down"`
+	Delay  string `mapstructure:"delay" yaml:"delay,omitempty"`
+}
+
+// LinkImpairment is a description of how a simulated link delays, drops and duplicates packets; applies to
+// both directions of a bidirectional link
+type LinkImpairment struct {
+	Latency     string  `mapstructure:"latency" yaml:"latency,omitempty"`
+	Jitter      string  `mapstructure:"jitter" yaml:"jitter,omitempty"`
+	Loss        float64 `mapstructure:"loss" yaml:"loss,omitempty"`
+	Duplication float64 `mapstructure:"duplication" yaml:"duplication,omitempty"`
 }
 
 // Host is a description of a simulated host
 type Host struct {
-	ID   string        `mapstructure:"id" yaml:"id"`
-	NICs []NIC         `mapstructure:"nics" yaml:"nics"`
-	Pos  *GridPosition `mapstructure:"pos" yaml:"pos"`
+	ID       string        `mapstructure:"id" yaml:"id"`
+	NICs     []NIC         `mapstructure:"nics" yaml:"nics"`
+	Pos      *GridPosition `mapstructure:"pos" yaml:"pos"`
+	Behavior *HostBehavior `mapstructure:"behavior" yaml:"behavior,omitempty"`
+	Groups   []string      `mapstructure:"multicast_groups" yaml:"multicast_groups,omitempty"`
+}
+
+// HostBehavior is a description of how a simulated host emits traffic; profile is one of
+// default, silent, chatty or bursty
+type HostBehavior struct {
+	Profile      string   `mapstructure:"profile" yaml:"profile,omitempty"`
+	Interval     string   `mapstructure:"interval" yaml:"interval,omitempty"`
+	BurstSize    int      `mapstructure:"burst_size" yaml:"burst_size,omitempty"`
+	ARPTargets   []string `mapstructure:"arp_targets" yaml:"arp_targets,omitempty"`
+	IGMPVersion  int      `mapstructure:"igmp_version" yaml:"igmp_version,omitempty"`
+	LACP         bool     `mapstructure:"lacp" yaml:"lacp,omitempty"`
+	LACPFastRate bool     `mapstructure:"lacp_fast_rate" yaml:"lacp_fast_rate,omitempty"`
 }
 
 // NIC is a description of a simulated NIC